@@ -0,0 +1,60 @@
+// Package abi embeds the mev-commit contract ABIs so they can be loaded from any working directory.
+// The typed bindings in core/contracts are generated from the same files.
+package abi
+
+//go:generate abigen --abi BidderRegistry.abi --pkg bidderregistry --type BidderRegistry --out ../core/contracts/bidderregistry/bidderregistry.go
+//go:generate abigen --abi BlockTracker.abi --pkg blocktracker --type BlockTracker --out ../core/contracts/blocktracker/blocktracker.go
+//go:generate abigen --abi PreConfCommitmentStore.abi --pkg preconfcommitmentstore --type PreConfCommitmentStore --out ../core/contracts/preconfcommitmentstore/preconfcommitmentstore.go
+//go:generate abigen --abi Oracle.abi --pkg oracle --type Oracle --out ../core/contracts/oracle/oracle.go
+//go:generate abigen --abi ProviderRegistry.abi --pkg providerregistry --type ProviderRegistry --out ../core/contracts/providerregistry/providerregistry.go
+//go:generate abigen --abi ValidatorRegistry.abi --pkg validatorregistry --type ValidatorRegistry --out ../core/contracts/validatorregistry/validatorregistry.go
+//go:generate abigen --abi L1Gateway.abi --pkg l1gateway --type L1Gateway --out ../core/contracts/l1gateway/l1gateway.go
+//go:generate abigen --abi SettlementGateway.abi --pkg settlementgateway --type SettlementGateway --out ../core/contracts/settlementgateway/settlementgateway.go
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+	"sync"
+
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+//go:embed *.abi
+var files embed.FS
+
+var (
+	parsedMu sync.Mutex
+	parsed   = make(map[string]gethabi.ABI)
+)
+
+// Raw returns the JSON ABI of the named contract, e.g. "BlockTracker".
+func Raw(name string) ([]byte, error) {
+	data, err := files.ReadFile(name + ".abi")
+	if err != nil {
+		return nil, fmt.Errorf("no embedded ABI for contract %q: %w", name, err)
+	}
+	return data, nil
+}
+
+// Parsed returns the parsed ABI of the named contract. Each ABI is only parsed once.
+func Parsed(name string) (gethabi.ABI, error) {
+	parsedMu.Lock()
+	defer parsedMu.Unlock()
+
+	if a, ok := parsed[name]; ok {
+		return a, nil
+	}
+
+	data, err := Raw(name)
+	if err != nil {
+		return gethabi.ABI{}, err
+	}
+	a, err := gethabi.JSON(strings.NewReader(string(data)))
+	if err != nil {
+		return gethabi.ABI{}, fmt.Errorf("failed to parse ABI of contract %q: %w", name, err)
+	}
+
+	parsed[name] = a
+	return a, nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/primev/preconf_blob_bidder/core/beacon"
+)
+
+func runBeaconStandIn(args []string) {
+	fs, _ := newFlagSet("beacon-standin")
+	addr := fs.String("addr", "127.0.0.1:5052", "Address to serve the beacon node API on")
+	pubkeys := fs.String("pubkeys", "", "Comma separated BLS pubkeys to assign as proposers, round robin by slot")
+	pubkeysFile := fs.String("pubkeys-file", "", "File with one BLS pubkey per line to assign as proposers")
+	genesis := fs.Int64("genesis-time", 0, "Unix time of slot 0. Defaults to now")
+	fs.Parse(args)
+
+	var keys []string
+	for _, key := range strings.Split(*pubkeys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if *pubkeysFile != "" {
+		data, err := os.ReadFile(*pubkeysFile)
+		if err != nil {
+			log.Fatalf("Failed to read pubkeys file: %v", err)
+		}
+		for _, key := range strings.Split(string(data), "\n") {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		log.Fatal("At least one pubkey is required. Use -pubkeys or -pubkeys-file to provide them.")
+	}
+
+	genesisTime := time.Now()
+	if *genesis != 0 {
+		genesisTime = time.Unix(*genesis, 0)
+	}
+
+	fmt.Printf("Serving proposer duties for %d validators on http://%s\n", len(keys), *addr)
+	standIn := &beacon.StandIn{Pubkeys: keys, GenesisTime: genesisTime}
+	if err := http.ListenAndServe(*addr, standIn); err != nil {
+		log.Fatalf("Beacon stand-in stopped: %v", err)
+	}
+}
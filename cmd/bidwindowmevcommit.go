@@ -2,84 +2,136 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
-
-	"flag"
 	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
 
 	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
 )
 
-// run with go run cmd/bidwindowmevcommit.go --privatekey "private key" --endpoint "endpoint"
-// This script mimics the same bidder functionality in the mev-commit bidder API, but calling the smart contracts
-// directly using Geth. The minimum bid amount is retrieved from the blockTracker contract and used as the default
-// deposit amount. Once the amount is deposited, the script calls `getDeposit` to confirm the deposit.
-
-// The script needs to wait about 12 minutes before the funds are available to be withdrawn. This is an overestimation. Not sure why.
-// Each window is 10 blocks, so about 120 seconds. After 360 seconds, or 6 minutes it should be good to withdraw from the window.
-// The oracle lag also needs to be taken into account, which lags behind by 20 blocks.
-
-func biddingWindow() {
-	endpoint := flag.String("endpoint", "", "The Ethereum client endpoint")
-	privateKeyHex := flag.String("privatekey", "", "The private key in hex format")
-	flag.Parse()
-	if *endpoint == "" {
-		log.Fatal("Endpoint is required. Use the -endpoint flag to provide it.")
+// run with go run ./cmd window deposit --privatekey "private key" --mev-commit-endpoint "endpoint"
+// This command mimics the same bidder functionality in the mev-commit bidder API, but calling the smart contracts
+// directly using Geth. The minimum bid amount is retrieved from the bidderRegistry contract and used as the default
+// deposit amount. Once the amount is deposited, the command calls `getDeposit` to confirm the deposit.
+
+// Funds can only be withdrawn once the window has been settled, run `window withdraw --window N` afterwards or
+// keep `window sweep` running to withdraw every deposited window as soon as it settles.
+// Each window is 10 blocks, so about 120 seconds. The oracle lag also needs to be taken into account, which lags
+// behind by 20 blocks.
+
+func runWindow(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "Usage: window <deposit|withdraw|sweep> [flags]")
+		os.Exit(2)
 	}
 
-	client, err := bb.NewGethClient(*endpoint)
-	if err != nil {
-		log.Fatalf("Failed to connect to MEV-Commit chain: %v", err)
+	switch args[0] {
+	case "deposit":
+		runWindowDeposit(args[1:])
+	case "withdraw":
+		runWindowWithdraw(args[1:])
+	case "sweep":
+		runWindowSweep(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown window command %q. Use deposit, withdraw or sweep.\n", args[0])
+		os.Exit(2)
 	}
+}
+
+func runWindowDeposit(args []string) {
+	fs, cf := newFlagSet("window deposit")
+	window := fs.Uint64("window", 0, "The window to deposit into. Defaults to the current window")
+	fs.Parse(args)
+
+	client := cf.mevCommitClient()
 
 	// Get block number for mev-commit
 	blockNumber, err := client.BlockNumber(context.Background())
 	if err != nil {
-		log.Println(err)
-		return
+		log.Fatalf("Failed to retrieve block number: %v", err)
 	}
 	log.Println("mev-commit Block Number: ", blockNumber)
 
 	// Get current bidding window
-	currentWindow, err := bb.WindowHeight(client)
-	if err != nil {
-		log.Println(err)
-		return
+	depositWindow := new(big.Int).SetUint64(*window)
+	if *window == 0 {
+		depositWindow, err = bb.WindowHeight(client)
+		if err != nil {
+			log.Fatalf("Failed to get current window: %v", err)
+		}
+		log.Println("Current Bidding Window: ", depositWindow)
 	}
-	log.Println("Current Bidding Window: ", currentWindow)
 
 	// Authenticate address
-	authAcct, err := bb.AuthenticateAddress(*privateKeyHex, client)
-	if err != nil {
-		log.Fatalf("Failed to Authenticate private key: %v", err)
-	}
+	authAcct := cf.authAcct(client, bb.CurrentNetwork().MevCommitChainIDBig())
 
-	tx, err := bb.DepositIntoWindow(client, currentWindow, authAcct)
+	tx, err := bb.DepositIntoWindow(client, depositWindow, authAcct)
 	if err != nil {
 		log.Fatalf("Failed to deposit into window: %v", err)
 	}
 
 	fmt.Printf("Transaction sent: %s\n", tx.Hash().Hex())
 
-	depositAmount, err := bb.GetDepositAmount(client, authAcct.Address, *currentWindow)
+	depositAmount, err := bb.GetDepositAmount(client, authAcct.Address, *depositWindow)
 	if err != nil {
 		log.Fatalf("Failed to get deposit amount: %v", err)
 	}
-	fmt.Printf("The address %s deposited in window %d the amount %d\n", authAcct.Address, currentWindow, depositAmount)
+	fmt.Printf("The address %s deposited in window %d the amount %d\n", authAcct.Address, depositWindow, depositAmount)
+}
 
-	// Wait for 11 minutes before withdrawing. This is an overestimated time to ensure that the next window has started.
-	log.Println("Waiting for 11 minutes before withdrawing...")
-	time.Sleep(11 * time.Minute)
+func runWindowWithdraw(args []string) {
+	fs, cf := newFlagSet("window withdraw")
+	window := fs.Uint64("window", 0, "The window to withdraw from")
+	fs.Parse(args)
 
-	// PART 3: WITHDRAW FUNDS
-	// withdraw funds
-	// withdrawBidderAmountFromWindow(address payable bidder,uint256 window)
+	if *window == 0 {
+		log.Fatal("Window is required. Use the -window flag to provide it.")
+	}
+
+	client := cf.mevCommitClient()
+	authAcct := cf.authAcct(client, bb.CurrentNetwork().MevCommitChainIDBig())
 
-	withdrawalTx, err := bb.WithdrawFromWindow(client, authAcct, currentWindow)
+	// withdrawBidderAmountFromWindow(address payable bidder,uint256 window)
+	withdrawalTx, err := bb.WithdrawFromWindow(context.Background(), client, authAcct, new(big.Int).SetUint64(*window))
 	if err != nil {
 		log.Fatalf("Failed to withdraw funds: %v", err)
 	}
 	fmt.Printf("Withdrawal Transaction sent: %s\n", withdrawalTx.Hash().Hex())
+}
 
+func runWindowSweep(args []string) {
+	fs, cf := newFlagSet("window sweep")
+	statePath := fs.String("state", "data/sweeper.json", "File to keep the sweeper progress and withdrawals in")
+	oracleLag := fs.Uint64("oracle-lag", bb.DefaultOracleLagBlocks, "Number of L1 blocks the oracle lags behind before settling")
+	startWindow := fs.Uint64("start-window", 0, "First window to sweep when there is no saved state")
+	lookback := fs.Uint64("lookback", 100, "Windows before the current one to scan when there is no saved state or -start-window")
+	fs.Parse(args)
+
+	enableGethLogs()
+
+	client := cf.mevCommitClient()
+	authAcct := cf.authAcct(client, bb.CurrentNetwork().MevCommitChainIDBig())
+
+	sweeper, err := bb.NewSweeper(client, authAcct, bb.SweeperConfig{
+		Endpoint:        cf.mevCommitEndpoint(),
+		StatePath:       *statePath,
+		OracleLagBlocks: *oracleLag,
+		StartWindow:     *startWindow,
+		Lookback:        *lookback,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create sweeper: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := sweeper.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatalf("Sweeper stopped: %v", err)
+	}
+	fmt.Printf("Sweeper stopped after %d withdrawals\n", len(sweeper.Withdrawals()))
 }
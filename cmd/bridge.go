@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/preconf_blob_bidder/core/bridge"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runBridge(args []string) {
+	fs, cf := newFlagSet("bridge")
+	to := fs.String("to", "mev-commit", "Destination chain: mev-commit or l1")
+	amount := fs.String("amount", "", "Amount in wei to send, including the bridge fee")
+	recipient := fs.String("recipient", "", "Recipient on the destination chain. Defaults to the address of the private key")
+	timeout := fs.Duration("timeout", 30*time.Minute, "How long to wait for the transfer to be finalized")
+	quoteOnly := fs.Bool("quote", false, "Only show the fees and the amount the recipient would receive")
+	fs.Parse(args)
+
+	dir, err := bridge.ParseDirection(*to)
+	if err != nil {
+		log.Fatal(err)
+	}
+	value, ok := new(big.Int).SetString(*amount, 10)
+	if !ok || value.Sign() <= 0 {
+		log.Fatal("Amount is required. Use the -amount flag to provide it in wei.")
+	}
+
+	l1Client := cf.l1Client()
+	mevCommitClient := cf.mevCommitClient()
+	b := bridge.New(l1Client, mevCommitClient)
+
+	quote, err := b.Quote(dir, value)
+	if err != nil {
+		log.Fatalf("Failed to quote transfer: %v", err)
+	}
+	fmt.Printf("Sending %s wei to %s: counterparty fee %s wei, finalization fee %s wei, recipient should receive %s wei\n",
+		quote.Amount, dir, quote.CounterpartyFee, quote.FinalizationFee, quote.Received)
+	if *quoteOnly {
+		return
+	}
+
+	var authAcct *bb.AuthAcct
+	if dir == bridge.ToMevCommit {
+		authAcct = cf.authAcct(l1Client, bb.CurrentNetwork().L1ChainIDBig())
+	} else {
+		authAcct = cf.authAcct(mevCommitClient, bb.CurrentNetwork().MevCommitChainIDBig())
+	}
+
+	recipientAddress := authAcct.Address
+	if *recipient != "" {
+		if !common.IsHexAddress(*recipient) {
+			log.Fatalf("Invalid recipient address: %s", *recipient)
+		}
+		recipientAddress = common.HexToAddress(*recipient)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	transfer, finalized, err := b.Send(ctx, dir, authAcct, recipientAddress, value, printBridgeProgress)
+	if errors.Is(err, bridge.ErrTimeout) {
+		log.Fatalf("%v. The transfer may still be finalized later, initiated in tx %s", err, transfer.TxHash.Hex())
+	}
+	if err != nil {
+		log.Fatalf("Bridge transfer failed: %v", err)
+	}
+	// TransferFinalized carries the transferred amount, the destination gateway keeps its finalization fee out of it
+	received := new(big.Int).Sub(finalized.Amount, quote.FinalizationFee)
+	fmt.Printf("Transfer %s of %s wei finalized in tx %s: %s received %s wei\n", transfer.TransferIdx, finalized.Amount, finalized.TxHash.Hex(), transfer.Recipient.Hex(), received)
+}
+
+func printBridgeProgress(p bridge.Progress) {
+	switch p.Stage {
+	case bridge.StageInitiated:
+		fmt.Printf("Transfer %s initiated in tx %s, waiting for the relayer to finalize it\n", p.Transfer.TransferIdx, p.Transfer.TxHash.Hex())
+	case bridge.StageWaiting:
+		if p.Err != nil {
+			log.Printf("Waiting %s: %v", p.Elapsed.Round(time.Second), p.Err)
+			return
+		}
+		fmt.Printf("Waiting %s, destination chain at block %d\n", p.Elapsed.Round(time.Second), p.DestinationBlock)
+	case bridge.StageFinalized:
+		fmt.Printf("Finalized in block %d after %s\n", p.DestinationBlock, p.Elapsed.Round(time.Second))
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runCommitments(args []string) {
+	fs, cf := newFlagSet("commitments")
+	bidders := fs.String("bidder", "", "Comma separated bidder addresses to show commitments for. Defaults to all bidders")
+	committers := fs.String("committer", "", "Comma separated provider addresses to show commitments from. Defaults to all providers")
+	fromBlock := fs.Uint64("from-block", 0, "First mev-commit chain block to show commitments from. Defaults to the current head")
+	fs.Parse(args)
+
+	filter := bb.CommitmentFilter{
+		Bidders:    parseAddressList("bidder", *bidders),
+		Committers: parseAddressList("committer", *committers),
+		FromBlock:  *fromBlock,
+	}
+
+	enableGethLogs()
+	endpoint := cf.mevCommitEndpoint()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	events, err := bb.SubscribeCommitmentStored(ctx, endpoint, filter)
+	if err != nil {
+		log.Fatalf("Failed to subscribe to CommitmentStored events: %v", err)
+	}
+
+	for ev := range events {
+		status := "stored"
+		if ev.Raw.Removed {
+			status = "removed by reorg"
+		}
+		fmt.Printf("Commitment %x %s in block %d: bidder %s, provider %s, bid %d wei, L1 block %d, txs %s\n",
+			ev.CommitmentIndex, status, ev.Raw.BlockNumber, ev.Bidder.Hex(), ev.Commiter.Hex(), ev.Bid, ev.BlockNumber, ev.TxnHash)
+	}
+}
+
+// parseAddressList parses a comma separated list of addresses, exiting on an invalid entry.
+func parseAddressList(name, list string) []common.Address {
+	var addresses []common.Address
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !common.IsHexAddress(s) {
+			log.Fatalf("Invalid %s address: %s", name, s)
+		}
+		addresses = append(addresses, common.HexToAddress(s))
+	}
+	return addresses
+}
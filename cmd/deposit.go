@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+)
+
+func runDeposit(args []string) {
+	fs, cf := newFlagSet("deposit")
+	amount := fs.String("amount", "", "The amount to deposit in wei")
+	window := fs.Uint64("window", 0, "The window to deposit into. Defaults to the current window")
+	auto := fs.Bool("auto", false, "Deposit the amount into every new window until auto deposit is cancelled")
+	cancelAuto := fs.Bool("cancel-auto", false, "Cancel auto deposit instead of depositing")
+	withdraw := fs.Bool("withdraw", false, "With -cancel-auto, also withdraw the deposits of past windows")
+	fs.Parse(args)
+
+	bidderClient := cf.bidderClient()
+	ctx := context.Background()
+
+	if *cancelAuto {
+		windows, err := bidderClient.CancelAutoDeposit(ctx, *withdraw)
+		if err != nil {
+			log.Fatalf("Failed to cancel auto deposit: %v", err)
+		}
+		fmt.Printf("Auto deposit cancelled. Withdrawn windows: %v\n", windows)
+		return
+	}
+
+	value, ok := new(big.Int).SetString(*amount, 10)
+	if !ok || value.Sign() <= 0 {
+		log.Fatal("Amount is required. Use the -amount flag to provide a positive amount in wei.")
+	}
+
+	if *auto {
+		result, err := bidderClient.AutoDeposit(ctx, value)
+		if err != nil {
+			log.Fatalf("Failed to start auto deposit: %v", err)
+		}
+		fmt.Printf("Auto deposit of %s wei per window started in window %d\n", result.AmountPerWindow, result.StartWindow)
+		return
+	}
+
+	deposit, err := bidderClient.Deposit(ctx, value, *window)
+	if err != nil {
+		log.Fatalf("Failed to deposit: %v", err)
+	}
+	fmt.Printf("Deposited %s wei into window %d\n", deposit.Amount, deposit.Window)
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"flag"
 	"log"
 	"math/big"
 
@@ -9,25 +8,15 @@ import (
 	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
 )
 
-func sendTransfer() {
-	endpoint := flag.String("endpoint", "", "The Ethereum client endpoint")
-	privateKeyHex := flag.String("privatekey", "", "The private key in hex format")
-	flag.Parse()
-	if *endpoint == "" {
-		log.Fatal("Endpoint is required. Use the -endpoint flag to provide it.")
-	}
+func runTransfer(args []string) {
+	fs, cf := newFlagSet("transfer")
+	fs.Parse(args)
 
 	// Start Client
-	client, err := bb.NewGethClient(*endpoint)
-	if err != nil {
-		log.Fatalf("Failed to connect to client: %v", err)
-	}
+	client := cf.l1Client()
 
 	// Authenticate address
-	authAcct, err := bb.AuthenticateAddress(*privateKeyHex, client)
-	if err != nil {
-		log.Fatalf("Failed to authenticate private key: %v", err)
-	}
+	authAcct := cf.authAcct(client, bb.CurrentNetwork().L1ChainIDBig())
 
 	// Send ETH Transfer
 	txHash, err := ee.SelfETHTransfer(client, *authAcct, big.NewInt(100000), 3000000, []byte{0x4c, 0xdc, 0xeb, 0x20})
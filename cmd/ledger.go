@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runLedger(args []string) {
+	fs, cf := newFlagSet("ledger")
+	bidder := fs.String("bidder", "", "The bidder address. Defaults to the address of the private key")
+	from := fs.Uint64("from", 0, "First window of the range. Defaults to -count windows before the current window")
+	to := fs.Uint64("to", 0, "Last window of the range. Defaults to the current window")
+	count := fs.Uint64("count", 10, "Number of windows to show when -from is not set")
+	oracleLag := fs.Uint64("oracle-lag", bb.DefaultOracleLagBlocks, "Number of L1 blocks the oracle lags behind before settling")
+	fs.Parse(args)
+
+	client := cf.mevCommitClient()
+
+	var bidderAddress common.Address
+	if *bidder != "" {
+		if !common.IsHexAddress(*bidder) {
+			log.Fatalf("Invalid bidder address: %s", *bidder)
+		}
+		bidderAddress = common.HexToAddress(*bidder)
+	} else {
+		bidderAddress = cf.authAcct(client, bb.CurrentNetwork().MevCommitChainIDBig()).Address
+	}
+
+	schedule, err := bb.FetchWindowSchedule(client, *oracleLag)
+	if err != nil {
+		log.Fatalf("Failed to get window schedule: %v", err)
+	}
+
+	toWindow := *to
+	if toWindow == 0 {
+		toWindow = schedule.CurrentWindow
+	}
+	fromWindow := *from
+	if fromWindow == 0 {
+		fromWindow = 1
+		if toWindow > *count {
+			fromWindow = toWindow - *count + 1
+		}
+	}
+
+	rows, err := bb.DepositLedger(client, bidderAddress, fromWindow, toWindow, schedule)
+	if err != nil {
+		log.Fatalf("Failed to get deposit ledger: %v", err)
+	}
+
+	fmt.Printf("Bidder %s, current window %d, %d blocks per window\n", bidderAddress.Hex(), schedule.CurrentWindow, schedule.BlocksPerWindow)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "WINDOW\tDEPOSIT (wei)\tLOCKED (wei)\tSTATUS")
+	for _, row := range rows {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", row.Window, row.Deposit, row.LockedFunds, row.Status)
+	}
+	w.Flush()
+}
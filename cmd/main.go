@@ -0,0 +1,263 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/console/prompt"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+// command is a subcommand of the bidder binary.
+type command struct {
+	name  string
+	usage string
+	run   func(args []string)
+}
+
+var commands = []command{
+	{"blob", "Send blob transactions and attach a preconf bid to each one", runBlob},
+	{"transfer", "Send an ETH transfer to self", runTransfer},
+	{"preconf-transfer", "Send an ETH transfer to self with a preconf bid", runPreconfTransfer},
+	{"window", "Deposit into or withdraw from a bidding window on the mev-commit chain (deposit|withdraw)", runWindow},
+	{"deposit", "Deposit into the bidder registry through the mev-commit bidder node", runDeposit},
+	{"status", "Show the bidder node's deposits and the current bidding window", runStatus},
+	{"ledger", "Show a bidder's deposits and locked funds across a range of windows", runLedger},
+	{"commitments", "Follow the commitments opened on the mev-commit chain", runCommitments},
+	{"report", "Report what a bidder paid providers, got refunded and still has locked, by window and provider", runReport},
+	{"providers", "Score the providers that committed to our bids by latency, slash rate and stake", runProviders},
+	{"bridge", "Bridge ETH between L1 and the mev-commit chain", runBridge},
+	{"topup", "Keep the bidder balance on the mev-commit chain funded by bridging from L1", runTopUp},
+	{"validators", "Manage validator opt-in through the ValidatorRegistry on L1 (list|status|stake|unstake|withdraw)", runValidators},
+	{"beacon-standin", "Serve stand-in beacon node proposer duties for trying -beacon-url offline", runBeaconStandIn},
+	{"track", "Show what happened to the bids for a tx, or follow their commitments until settlement", runTrack},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, cmd := range commands {
+		if cmd.name == name {
+			cmd.run(os.Args[2:])
+			return
+		}
+	}
+
+	if name != "help" && name != "-h" && name != "--help" {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", name)
+		usage()
+		os.Exit(2)
+	}
+	usage()
+}
+
+func usage() {
+	prog := filepath.Base(os.Args[0])
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags]\n\nCommands:\n", prog)
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-18s %s\n", cmd.name, cmd.usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun '%s <command> -h' for the flags of a command.\n", prog)
+}
+
+// commonFlags holds the flags shared by every command. Flags that are set take precedence over the
+// selected profile of the config file and the PRECONF_* environment variables.
+type commonFlags struct {
+	configPath    string
+	profile       string
+	network       string
+	geth          bb.GethConfig
+	privateKeyHex string
+	serverAddress string
+	signerCfg     bb.SignerConfig
+
+	cfg          *bb.Config
+	signerLoaded bool
+	signerCache  bb.Signer
+}
+
+// newFlagSet creates the flag set for a command with the shared flags registered.
+func newFlagSet(name string) (*flag.FlagSet, *commonFlags) {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	cf := &commonFlags{}
+	fs.StringVar(&cf.configPath, "config", "", "Path to a JSON or YAML config file")
+	fs.StringVar(&cf.profile, "profile", "", "The config file profile to use. Defaults to $PRECONF_PROFILE or the file's default_profile")
+	fs.StringVar(&cf.network, "network", "", "The mev-commit network to target (default holesky)")
+	fs.StringVar(&cf.geth.L1Endpoint, "l1-endpoint", "", "The L1 node endpoint. Defaults to the network's L1 RPC")
+	fs.StringVar(&cf.geth.MevCommitEndpoint, "mev-commit-endpoint", "", "The mev-commit chain node endpoint. Defaults to the network's mev-commit RPC")
+	fs.StringVar(&cf.privateKeyHex, "privatekey", "", "The private key in hex format, for development. Prefer -keystore or -remote-signer")
+	fs.StringVar(&cf.signerCfg.Keystore, "keystore", "", "Encrypted geth keystore file to sign with")
+	fs.StringVar(&cf.signerCfg.PasswordFile, "keystore-password-file", "", "File with the keystore password. Prompted for when not set")
+	fs.StringVar(&cf.signerCfg.RemoteURL, "remote-signer", "", "URL of a web3signer or clef JSON-RPC endpoint to sign with")
+	fs.StringVar(&cf.signerCfg.RemoteMethod, "remote-signer-method", "", "Signing method of the remote signer: eth_signTransaction (web3signer, default) or account_signTransaction (clef)")
+	fs.StringVar(&cf.signerCfg.Address, "signer-address", "", "The account the remote signer signs for")
+	fs.StringVar(&cf.serverAddress, "server-address", "", "The mev-commit bidder node gRPC address (default 127.0.0.1:13524)")
+	return fs, cf
+}
+
+// config loads the config file and environment and applies the flags on top, exiting if the result is invalid.
+func (cf *commonFlags) config() *bb.Config {
+	if cf.cfg != nil {
+		return cf.cfg
+	}
+
+	cfg, err := bb.LoadConfig(cf.configPath, cf.profile)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	if cf.network != "" {
+		cfg.Network = cf.network
+	}
+	setFlag(&cfg.Geth.L1Endpoint, cf.geth.L1Endpoint)
+	setFlag(&cfg.Geth.MevCommitEndpoint, cf.geth.MevCommitEndpoint)
+	if cf.privateKeyHex != "" {
+		cfg.PrivateKey = cf.privateKeyHex
+	}
+	if cf.serverAddress != "" {
+		cfg.Bidder.ServerAddress = cf.serverAddress
+	}
+	// a signer flag replaces the signer of the profile, and -privatekey replaces both
+	switch {
+	case cf.signerCfg.Keystore != "":
+		cfg.Signer.RemoteURL = ""
+	case cf.signerCfg.RemoteURL != "":
+		cfg.Signer.Keystore = ""
+	case cf.privateKeyHex != "":
+		cfg.Signer.Keystore, cfg.Signer.RemoteURL = "", ""
+	}
+	setFlag(&cfg.Signer.Keystore, cf.signerCfg.Keystore)
+	setFlag(&cfg.Signer.PasswordFile, cf.signerCfg.PasswordFile)
+	setFlag(&cfg.Signer.RemoteURL, cf.signerCfg.RemoteURL)
+	setFlag(&cfg.Signer.RemoteMethod, cf.signerCfg.RemoteMethod)
+	setFlag(&cfg.Signer.Address, cf.signerCfg.Address)
+	// validate once the flags are merged, so a flag can fix what the file or environment leaves out
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
+
+	network, err := bb.LookupNetwork(cfg.Network)
+	if err != nil {
+		log.Fatalf("Invalid config: %v", err)
+	}
+	bb.SetNetwork(network)
+
+	cf.cfg = cfg
+	return cfg
+}
+
+// l1Client connects to the L1 endpoint, exiting if it is missing or unreachable.
+func (cf *commonFlags) l1Client() *ethclient.Client {
+	return gethClient(cf.l1Endpoint())
+}
+
+// mevCommitClient connects to the mev-commit chain endpoint, exiting if it is missing or unreachable.
+func (cf *commonFlags) mevCommitClient() *ethclient.Client {
+	return gethClient(cf.mevCommitEndpoint())
+}
+
+// l1Endpoint returns the configured L1 endpoint or the network's L1 RPC, exiting if both are missing.
+func (cf *commonFlags) l1Endpoint() string {
+	endpoint := cf.config().Geth.L1Endpoint
+	if endpoint == "" {
+		endpoint = bb.CurrentNetwork().L1RPC
+	}
+	if endpoint == "" {
+		log.Fatalf("The L1 endpoint is required. Use the -l1-endpoint flag, $%s or the config file to provide it.", bb.EnvL1Endpoint)
+	}
+	return endpoint
+}
+
+// hasMevCommitEndpoint reports whether a mev-commit chain endpoint is configured or known for the network.
+func (cf *commonFlags) hasMevCommitEndpoint() bool {
+	return cf.config().Geth.MevCommitEndpoint != "" || bb.CurrentNetwork().MevCommitRPC != ""
+}
+
+// mevCommitEndpoint returns the configured mev-commit chain endpoint or the network's mev-commit RPC, exiting if
+// both are missing.
+func (cf *commonFlags) mevCommitEndpoint() string {
+	endpoint := cf.config().Geth.MevCommitEndpoint
+	if endpoint == "" {
+		endpoint = bb.CurrentNetwork().MevCommitRPC
+	}
+	if endpoint == "" {
+		log.Fatalf("The mev-commit chain endpoint is required. Use the -mev-commit-endpoint flag, $%s or the config file to provide it.", bb.EnvMevCommitEndpoint)
+	}
+	return endpoint
+}
+
+// gethClient connects to endpoint, exiting if it is unreachable.
+func gethClient(endpoint string) *ethclient.Client {
+	client, err := bb.NewGethClient(endpoint)
+	if err != nil {
+		log.Fatalf("Failed to connect to geth client: %v", err)
+	}
+	return client
+}
+
+// signer returns the configured keystore, remote signer or private key signer, or nil if none is configured.
+// A keystore password is only prompted for once.
+func (cf *commonFlags) signer() bb.Signer {
+	if !cf.signerLoaded {
+		cfg := cf.config()
+		signer, err := bb.NewSigner(cfg.Signer, cfg.PrivateKey, promptPassword)
+		if err != nil {
+			log.Fatalf("Failed to load signer: %v", err)
+		}
+		cf.signerCache, cf.signerLoaded = signer, true
+	}
+	return cf.signerCache
+}
+
+// authAcct authenticates the configured signer for chainID, exiting if it is missing or invalid.
+// A nil chainID is read from the client.
+func (cf *commonFlags) authAcct(client *ethclient.Client, chainID *big.Int) *bb.AuthAcct {
+	signer := cf.signer()
+	if signer == nil {
+		log.Fatal("A signer is required. Use -keystore, -remote-signer, or $PRECONF_PRIVATE_KEY or the -privatekey flag for development.")
+	}
+
+	authAcct, err := bb.Authenticate(signer, client, chainID)
+	if err != nil {
+		log.Fatalf("Failed to authenticate signer: %v", err)
+	}
+	return authAcct
+}
+
+// promptPassword reads a password from the terminal without echoing it.
+func promptPassword(msg string) (string, error) {
+	return prompt.Stdin.PromptPassword(msg)
+}
+
+func setFlag(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+// enableGethLogs prints the go-ethereum logs of long running commands to stderr. They are discarded by default.
+func enableGethLogs() {
+	glogger := gethlog.NewGlogHandler(gethlog.NewTerminalHandler(os.Stderr, true))
+	glogger.Verbosity(gethlog.LevelInfo)
+	gethlog.SetDefault(gethlog.NewLogger(glogger))
+}
+
+// bidderClient connects to the configured mev-commit bidder node.
+func (cf *commonFlags) bidderClient() *bb.Bidder {
+	bidderClient, err := bb.NewBidderClient(cf.config().Bidder)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v. Remember to connect to the mev-commit p2p bidder node.", err)
+	}
+	fmt.Println("Connected to mev-commit client")
+	return bidderClient
+}
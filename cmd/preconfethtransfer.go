@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"flag"
 	"fmt"
 	"log"
 	"math/big"
@@ -13,20 +12,11 @@ import (
 	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
 )
 
-func sendETHTransfer() {
+func runPreconfTransfer(args []string) {
+	fs, cf := newFlagSet("preconf-transfer")
+	fs.Parse(args)
 
-	cfg := bb.BidderConfig{
-		// ServerAddress: "localhost:13524", // Default address for mevcommit gRPC server //
-		ServerAddress: "127.0.0.1:13524",
-		LogFmt:        "json", // Example log format
-		LogLevel:      "info", // Example log level
-	}
-
-	bidderClient, err := bb.NewBidderClient(cfg)
-	if err != nil {
-		log.Fatalf("Failed to create client: %v. Remember to connect to the mev-commit p2p bidder node.", err)
-	}
-	fmt.Println("Connected to mev-commit client")
+	bidderClient := cf.bidderClient()
 
 	// TODO 7/10 min deposit no longer exists in 0.4.0 release
 	// Get the minimum deposit and deposit the minimum amount in the current bid window
@@ -43,24 +33,11 @@ func sendETHTransfer() {
 	// }
 	// fmt.Printf("Deposited into window: %v\n", windowNumber)
 
-	// Start Holesky client with command line flags
-	endpoint := flag.String("endpoint", "", "The Ethereum client endpoint")
-	privateKeyHex := flag.String("privatekey", "", "The private key in hex format")
-	flag.Parse()
-	if *endpoint == "" {
-		log.Fatal("Endpoint is required. Use the -endpoint flag to provide it.")
-	}
-
-	client, err := bb.NewGethClient(*endpoint)
-	if err != nil {
-		log.Fatalf("Failed to connect to client: %v", err)
-	}
+	// Start Holesky client
+	client := cf.l1Client()
 
 	// Authenticate address with private key
-	authAcct, err := bb.AuthenticateAddress(*privateKeyHex, client)
-	if err != nil {
-		log.Fatalf("Failed to authenticate private key: %v", err)
-	}
+	authAcct := cf.authAcct(client, bb.CurrentNetwork().L1ChainIDBig())
 
 	// Get current block number
 	blockNumber, err := client.BlockNumber(context.Background())
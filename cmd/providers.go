@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runProviders(args []string) {
+	fs, cf := newFlagSet("providers")
+	trackerPath := fs.String("tracker", "data/tracker.json", "Tracker file to score the providers from")
+	scoresPath := fs.String("scores", "data/providers.json", "File to store the scoreboard in")
+	cached := fs.Bool("cached", false, "Show the stored scoreboard without rescoring")
+	noStake := fs.Bool("no-stake", false, "Don't read the providers' stake from the mev-commit chain")
+	fs.Parse(args)
+
+	var board *bb.ProviderScoreboard
+	if *cached {
+		var err error
+		board, err = bb.LoadProviderScoreboard(*scoresPath)
+		if err != nil {
+			log.Fatalf("Failed to load provider scoreboard: %v", err)
+		}
+	} else {
+		tracker, err := bb.NewTracker(*trackerPath)
+		if err != nil {
+			log.Fatalf("Failed to load tracker: %v", err)
+		}
+		board = tracker.ProviderScoreboard()
+
+		if !*noStake {
+			if err := bb.FetchProviderStakes(cf.mevCommitClient(), board); err != nil {
+				log.Printf("Failed to read provider stakes: %v", err)
+			}
+		}
+		if err := bb.SaveProviderScoreboard(*scoresPath, board); err != nil {
+			log.Fatalf("Failed to save provider scoreboard: %v", err)
+		}
+	}
+
+	if len(board.Providers) == 0 {
+		fmt.Println("No provider has committed to a tracked bid yet")
+		return
+	}
+
+	fmt.Printf("Providers scored at %s\n", time.Unix(board.UpdatedAt, 0).Format(time.RFC3339))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROVIDER\tCOMMITMENTS\tMEDIAN LATENCY (ms)\tPROCESSED\tSLASHED\tSLASH RATE\tSTAKE (wei)")
+	for _, p := range board.Providers {
+		stake := p.Stake
+		if stake == "" {
+			stake = "-"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.1f%%\t%s\n", p.Provider, p.Commitments, p.MedianLatencyMs, p.Processed, p.Slashed, p.SlashRate*100, stake)
+	}
+	w.Flush()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runReport(args []string) {
+	fs, cf := newFlagSet("report")
+	bidder := fs.String("bidder", "", "The bidder address. Defaults to the address of the private key")
+	fromBlock := fs.Uint64("from-block", 0, "First mev-commit chain block to read settlements from")
+	toBlock := fs.Uint64("to-block", 0, "Last mev-commit chain block to read settlements from. Defaults to the current head")
+	trackerPath := fs.String("tracker", "data/tracker.json", "Tracker file used to name the provider of refunded commitments")
+	format := fs.String("format", "table", "Output format: table or csv")
+	fs.Parse(args)
+
+	if *format != "table" && *format != "csv" {
+		log.Fatalf("Invalid format %q, use table or csv", *format)
+	}
+
+	client := cf.mevCommitClient()
+
+	var bidderAddress common.Address
+	if *bidder != "" {
+		if !common.IsHexAddress(*bidder) {
+			log.Fatalf("Invalid bidder address: %s", *bidder)
+		}
+		bidderAddress = common.HexToAddress(*bidder)
+	} else {
+		bidderAddress = cf.authAcct(client, bb.CurrentNetwork().MevCommitChainIDBig()).Address
+	}
+
+	tracker, err := bb.NewTracker(*trackerPath)
+	if err != nil {
+		log.Fatalf("Failed to load tracker: %v", err)
+	}
+
+	report, err := bb.BuildSettlementReport(client, bidderAddress, *fromBlock, *toBlock, tracker.CommitmentProviders())
+	if err != nil {
+		log.Fatalf("Failed to build settlement report: %v", err)
+	}
+
+	if *format == "csv" {
+		writeReportCSV(report)
+		return
+	}
+	writeReportTable(report)
+}
+
+func writeReportTable(report *bb.SettlementReport) {
+	fmt.Printf("Bidder %s, blocks %d-%d\n", report.Bidder.Hex(), report.FromBlock, report.ToBlock)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "WINDOW\tPROVIDER\tCOMMITMENTS\tPAID (wei)\tREFUNDED (wei)\tWITHDRAWN (wei)\tLOCKED (wei)")
+	for _, ws := range report.Windows {
+		for _, ps := range ws.Providers {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\t\t\n", ws.Window, providerName(ps.Provider), ps.Commitments, ps.Paid, ps.Refunded)
+		}
+		fmt.Fprintf(w, "%d\ttotal\t\t%s\t%s\t%s\t%s\n", ws.Window, ws.Paid, ws.Refunded, ws.Withdrawn, ws.Locked)
+	}
+	fmt.Fprintf(w, "all\ttotal\t\t%s\t%s\t%s\t%s\n", report.Paid, report.Refunded, report.Withdrawn, report.Locked)
+	w.Flush()
+}
+
+// writeReportCSV writes one row per window and provider, and one "total" row per window with the withdrawn and locked amounts.
+func writeReportCSV(report *bb.SettlementReport) {
+	w := csv.NewWriter(os.Stdout)
+	w.Write([]string{"window", "provider", "commitments", "paid_wei", "refunded_wei", "withdrawn_wei", "locked_wei"})
+	for _, ws := range report.Windows {
+		window := strconv.FormatUint(ws.Window, 10)
+		for _, ps := range ws.Providers {
+			w.Write([]string{window, providerName(ps.Provider), strconv.Itoa(ps.Commitments), ps.Paid.String(), ps.Refunded.String(), "", ""})
+		}
+		w.Write([]string{window, "total", "", ws.Paid.String(), ws.Refunded.String(), ws.Withdrawn.String(), ws.Locked.String()})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Fatalf("Failed to write CSV: %v", err)
+	}
+}
+
+func providerName(provider common.Address) string {
+	if provider == (common.Address{}) {
+		return "unknown"
+	}
+	return provider.Hex()
+}
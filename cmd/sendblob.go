@@ -2,52 +2,198 @@ package main
 
 import (
 	"context"
-	"flag"
+	"errors"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/ethclient"
+	pb "github.com/primev/preconf_blob_bidder/core/bidderpb"
+	"github.com/primev/preconf_blob_bidder/core/bridge"
 	ee "github.com/primev/preconf_blob_bidder/core/eth"
 	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var NUM_BLOBS = 6
 
-func main() {
-	cfg := bb.BidderConfig{
-		ServerAddress: "127.0.0.1:13524",
-		LogFmt:        "json",
-		LogLevel:      "info",
+var commitmentVerifier = bb.NewCommitmentVerifier(bb.DefaultPreConfDomain)
+
+// tracker records every bid and commitment so their settlement can be followed.
+var tracker *bb.Tracker
+
+// mevCommitClient is used to check confirmed txs against the block winners. Nil when no mev-commit endpoint is known.
+var mevCommitClient *ethclient.Client
+
+// lookahead picks the blocks with an opted-in proposer to bid on. Nil bids on the next block.
+var lookahead *bb.ProposerLookahead
+
+// bidTargets is the block the last bid for each pending tx targets.
+var bidTargets = make(map[string]int64)
+
+// bidderAddress is the mev-commit bidder node's address. When set, the bid each provider received is checked against it.
+var bidderAddress *common.Address
+
+// replacedTxs is the txs each pending tx replaced, oldest first. Any of them may still land instead.
+var replacedTxs = make(map[string][]string)
+
+// replaceTx re-sends a pending blob tx of authAcct with bumped fees. Nil when fee bumping is disabled.
+var replaceTx func(authAcct *bb.AuthAcct, tx *types.Transaction) (*types.Transaction, error)
+
+// bumpAfter is how many blocks a blob tx stays pending before its fees are bumped, at most maxBumps times.
+var bumpAfter, maxBumps int
+
+func runBlob(args []string) {
+	fs, cf := newFlagSet("blob")
+	private := fs.Bool("private", false, "Set to true for private transactions")
+	strategyName := fs.String("strategy", "fixed", "Bid pricing strategy: fixed, linear, exponential or fee")
+	bidAmount := fs.String("bid-amount", "250000000000000", "Base bid amount in wei. Minimum bid for the fee strategy")
+	bidStep := fs.String("bid-step", "50000000000000", "Amount in wei added per resend for the linear strategy")
+	bidFactor := fs.Float64("bid-factor", 1.5, "Multiplier applied per resend for the exponential strategy")
+	bidMultiplier := fs.Float64("bid-fee-multiplier", 1.0, "Multiple of the expected blob fee to bid for the fee strategy")
+	bidMax := fs.String("bid-max", "", "Maximum bid amount in wei. Empty for no cap")
+	bidder := fs.String("bidder-address", "", "Address of the mev-commit bidder node. Used to check the bid signature in each commitment")
+	trackerPath := fs.String("tracker", "data/tracker.json", "File to track the bids and their commitments in")
+	follow := fs.Bool("follow", false, "Follow the commitment events on the mev-commit chain while sending, see the track command")
+	beaconURL := fs.String("beacon-url", "", "Beacon node API URL. When set, bids only target blocks whose proposer is opted in to mev-commit")
+	bumpAfterBlocks := fs.Int("bump-after", 3, "Replace a blob tx with doubled fees after it has been pending for this many blocks. 0 never replaces")
+	maxPending := fs.Int("max-pending", 1, "Number of blob txs to keep in flight at once per account. Nonces are handed out locally, so later txs don't wait for earlier ones to confirm")
+	keysFile := fs.String("privatekeys-file", "", "File with one account per line: a hex private key, keystore:<file> [password file], or remote:<address> of the -remote-signer. Blob txs are sent from these accounts in turn, together with the configured signer's account if set")
+	maxBumpCount := fs.Int("max-bumps", 3, "Maximum number of fee bumps per blob tx")
+	file := fs.String("file", "", "Post the contents of this file in each blob tx instead of random blobs")
+	topUpThreshold := fs.String("topup-threshold", "", "Bridge -topup-amount from L1 when the bidder balance, minus the deposits needed for the current and next windows, is below this amount in wei. Requires -bidder-address")
+	topUpAmount := fs.String("topup-amount", "", "Amount in wei to bridge for each top-up, including the bridge fee")
+	topUpDailyCap := fs.String("topup-daily-cap", "", "Maximum amount in wei to bridge for top-ups in any 24 hours. Empty for no cap")
+	topUpKeystore := fs.String("topup-keystore", "", "Keystore file of the L1 account that pays for top-ups. It must not send blob txs, since geth holds back its other txs while a blob tx is pending")
+	topUpPasswordFile := fs.String("topup-keystore-password-file", "", "File with the -topup-keystore password. Prompted for when not set")
+	fs.Parse(args)
+
+	if *maxPending < 1 {
+		log.Fatal("-max-pending must be at least 1")
+	}
+
+	var payloadBlobs []kzg4844.Blob
+	if *file != "" {
+		payload, err := os.ReadFile(*file)
+		if err != nil {
+			log.Fatalf("Failed to read blob payload: %v", err)
+		}
+		if n := ee.BlobsNeeded(len(payload)); n > ee.MaxBlobsPerTx {
+			log.Fatalf("%s needs %d blobs, a blob tx carries at most %d", *file, n, ee.MaxBlobsPerTx)
+		}
+		if payloadBlobs, err = ee.EncodeBlobs(payload); err != nil {
+			log.Fatalf("Failed to encode blob payload: %v", err)
+		}
+	}
+
+	if *bidder != "" {
+		if !common.IsHexAddress(*bidder) {
+			log.Fatalf("Invalid bidder address: %s", *bidder)
+		}
+		address := common.HexToAddress(*bidder)
+		bidderAddress = &address
 	}
 
-	bidderClient, err := bb.NewBidderClient(cfg)
+	strategy, err := bb.NewBidStrategy(bb.StrategyConfig{
+		Name:       *strategyName,
+		Amount:     *bidAmount,
+		Step:       *bidStep,
+		Factor:     *bidFactor,
+		Multiplier: *bidMultiplier,
+		Max:        *bidMax,
+	})
 	if err != nil {
-		log.Fatalf("Failed to create client: %v. Remember to connect to the mev-commit p2p bidder node.", err)
+		log.Fatalf("Failed to create bid strategy: %v", err)
 	}
-	fmt.Println("Connected to mev-commit client")
 
-	endpoint := flag.String("endpoint", "", "The Ethereum client endpoint")
-	privateKeyHex := flag.String("privatekey", "", "The private key in hex format")
-	private := flag.Bool("private", false, "Set to true for private transactions")
+	tracker, err = bb.NewTracker(*trackerPath)
+	if err != nil {
+		log.Fatalf("Failed to load tracker: %v", err)
+	}
+
+	// the same endpoint the client is built from, also used for private sends
+	l1Endpoint := cf.l1Endpoint()
+	client := gethClient(l1Endpoint)
+	bidderClient := cf.bidderClient()
+
+	if *beaconURL != "" {
+		if bb.CurrentNetwork().Contracts.ValidatorRegistry == (common.Address{}) {
+			log.Fatalf("-beacon-url needs the ValidatorRegistry address, which is not configured for network %s. Set validator_registry under networks: in the config file.", bb.CurrentNetwork().Name)
+		}
+		lookahead = bb.NewProposerLookahead(*beaconURL, client)
+	}
+
+	if cf.hasMevCommitEndpoint() {
+		mevCommitClient = cf.mevCommitClient()
+	} else {
+		log.Printf("No mev-commit chain endpoint for network %s, confirmed txs won't be checked against their commitments", bb.CurrentNetwork().Name)
+	}
 
-	flag.Parse()
-	if *endpoint == "" {
-		log.Fatal("Endpoint is required. Use the -endpoint flag to provide it.")
+	if *follow {
+		endpoint := cf.mevCommitEndpoint()
+		go func() {
+			if err := tracker.Follow(context.Background(), endpoint); err != nil {
+				log.Printf("Stopped following commitment events: %v", err)
+			}
+		}()
 	}
 
-	client, err := bb.NewGethClient(*endpoint)
+	if *bumpAfterBlocks > 0 {
+		bumpAfter, maxBumps = *bumpAfterBlocks, *maxBumpCount
+		replaceTx = func(authAcct *bb.AuthAcct, tx *types.Transaction) (*types.Transaction, error) {
+			return ee.ReplaceBlobTransaction(client, l1Endpoint, *private, *authAcct, tx)
+		}
+	}
+
+	pool, err := ee.NewSenderPool(client, poolAccounts(cf, client, *keysFile))
 	if err != nil {
-		log.Fatalf("Failed to connect to geth client: %v", err)
+		log.Fatalf("Failed to create sender pool: %v", err)
+	}
+	if *topUpThreshold != "" || *topUpAmount != "" {
+		if bidderAddress == nil {
+			log.Fatal("The bidder address is required to top up its balance. Use the -bidder-address flag to provide it.")
+		}
+		if mevCommitClient == nil {
+			log.Fatal("The mev-commit chain endpoint is required to top up the bidder balance. Use the -mev-commit-endpoint flag, $PRECONF_MEV_COMMIT_ENDPOINT or the config file to provide it.")
+		}
+		if *topUpKeystore == "" {
+			log.Fatal("A separate funding account is required to top up the bidder balance. Use the -topup-keystore flag to provide it.")
+		}
+		funder := topUpAccount(client, *topUpKeystore, *topUpPasswordFile)
+		for _, s := range pool.Senders() {
+			if s.Account.Address == funder.Address {
+				log.Fatalf("The top-up account %s also sends blob txs. Use an account that doesn't.", funder.Address.Hex())
+			}
+		}
+
+		enableGethLogs()
+		topUp := newTopUp(client, mevCommitClient, funder, bridge.TopUpConfig{
+			Bidder:    *bidderAddress,
+			Threshold: parseWei("topup-threshold", *topUpThreshold, true),
+			Amount:    parseWei("topup-amount", *topUpAmount, true),
+			DailyCap:  parseWei("topup-daily-cap", *topUpDailyCap, false),
+		})
+		go func() {
+			if err := topUp.Run(context.Background()); err != nil {
+				log.Printf("Stopped topping up the bidder balance: %v", err)
+			}
+		}()
+	}
+
+	numBlobs := NUM_BLOBS
+	if payloadBlobs != nil {
+		numBlobs = len(payloadBlobs)
 	}
 
 	timer := time.NewTimer(12 * time.Hour)
 	blobCount := 0
-	pendingTxs := make(map[string]int64)
-	preconfCount := make(map[string]int)
 
 	for {
 		select {
@@ -55,16 +201,32 @@ func main() {
 			fmt.Println("2 hours have passed. Stopping the loop.")
 			return
 		default:
-			if len(pendingTxs) == 0 {
-				authAcct, err := bb.AuthenticateAddress(*privateKeyHex, client)
-				if err != nil {
-					log.Fatalf("Failed to authenticate private key: %v", err)
-				}
+			// Check pending transactions and resend preconfirmation bids if necessary
+			for _, s := range pool.Senders() {
+				checkPendingTxs(client, bidderClient, strategy, s)
+			}
+			verifyInclusions()
+
+			sender, err := pool.Next(context.Background(), *maxPending, numBlobs)
+			if err != nil {
+				log.Printf("Failed to pick a sender account: %v", err)
+			}
+			if sender != nil {
+				authAcct := sender.Account
 
-				txHash, err := ee.ExecuteBlobTransaction(client, *endpoint, *private, *authAcct, NUM_BLOBS)
+				blobs := payloadBlobs
+				if blobs == nil {
+					blobs = ee.RandomBlobs(NUM_BLOBS)
+				}
+				tx, err := ee.SendBlobTransaction(client, l1Endpoint, *private, *authAcct, blobs)
 				if err != nil {
-					log.Fatalf("Failed to execute blob transaction: %v", err)
+					// the nonce manager resyncs after a nonce error, so the next iteration retries with a fresh nonce
+					log.Printf("Failed to execute blob transaction from %s: %v", authAcct.Address.Hex(), err)
+					time.Sleep(3 * time.Second)
+					continue
 				}
+				txHash := tx.Hash().String()
+				sender.Txs[txHash] = tx
 
 				blockNumber, err := client.BlockNumber(context.Background())
 				if err != nil {
@@ -73,16 +235,15 @@ func main() {
 
 				// log.Printf("Sent tx %s at block number: %d", txHash, blockNumber)
 
-				pendingTxs[txHash] = int64(blockNumber)
-				preconfCount[txHash] = 1
+				sender.Pending[txHash] = int64(blockNumber)
+				sender.Bids[txHash] = 1
 				blobCount++
-				log.Printf("Number of blobs sent: %d", blobCount)
+				log.Printf("Number of blobs sent: %d, last from %s", blobCount, authAcct.Address.Hex())
 
 				// Send initial preconfirmation bid
-				sendPreconfBid(bidderClient, txHash, int64(blockNumber)+1)
-			} else {
-				// Check pending transactions and resend preconfirmation bids if necessary
-				checkPendingTxs(client, bidderClient, pendingTxs, preconfCount)
+				if target, ok := targetBlock(int64(blockNumber) + 1); ok {
+					sendPreconfBid(client, bidderClient, strategy, txHash, len(blobs), target, sender.Bids[txHash])
+				}
 			}
 
 			time.Sleep(3 * time.Second)
@@ -90,23 +251,117 @@ func main() {
 	}
 }
 
-func sendPreconfBid(bidderClient *bb.Bidder, txHash string, blockNumber int64) {
+// targetBlock returns the block to bid on: nextBlock, or with a lookahead the first block from nextBlock on whose
+// proposer is opted in. It returns false when no upcoming proposer is opted in, or when the lookahead fails and
+// the proposers can't be checked.
+func targetBlock(nextBlock int64) (int64, bool) {
+	if lookahead == nil {
+		return nextBlock, true
+	}
+
+	block, duty, err := lookahead.NextOptedInBlock(context.Background(), uint64(nextBlock))
+	if errors.Is(err, bb.ErrNoOptedInProposer) {
+		log.Printf("Not bidding: no opted-in proposer from block %d until the end of the next epoch", nextBlock)
+		return 0, false
+	}
+	if err != nil {
+		log.Printf("Not bidding: failed to look up upcoming proposers: %v", err)
+		return 0, false
+	}
+	if block != uint64(nextBlock) {
+		log.Printf("Skipping to block %d (slot %d), the first with an opted-in proposer", block, duty.Slot)
+	}
+	return int64(block), true
+}
+
+func sendPreconfBid(client *ethclient.Client, bidderClient *bb.Bidder, strategy bb.BidStrategy, txHash string, numBlobs int, blockNumber int64, attempt int) {
+	bidTargets[txHash] = blockNumber
+
+	blobBaseFee, err := ee.NextBlobBaseFee(client)
+	if err != nil {
+		log.Printf("Failed to retrieve blob base fee: %v", err)
+	}
+
+	amount := strategy.BidAmount(bb.BidContext{
+		Attempt:     attempt,
+		NumBlobs:    numBlobs,
+		BlobBaseFee: blobBaseFee,
+	}).String() // amount is in wei
+
 	currentTime := time.Now().UnixMilli()
-	amount := "250000000000000" // amount is in wei. Equivalent to .00025 ETH bids
 	decayStart := currentTime
 	decayEnd := currentTime + (time.Duration(12 * time.Second).Milliseconds()) // bid decay is 24 seconds (2 blocks)
 
-	_, err := bidderClient.SendBid([]string{strings.TrimPrefix(txHash, "0x")}, amount, blockNumber, decayStart, decayEnd)
-	if err != nil {
+	// stop waiting for commitments once the bid has fully decayed
+	ctx, cancel := context.WithDeadline(context.Background(), time.UnixMilli(decayEnd))
+	defer cancel()
+
+	txHashes := []string{strings.TrimPrefix(txHash, "0x")}
+	sentBid := &pb.Bid{
+		TxHashes:            txHashes,
+		Amount:              amount,
+		BlockNumber:         blockNumber,
+		DecayStartTimestamp: decayStart,
+		DecayEndTimestamp:   decayEnd,
+	}
+
+	// recorded before sending, so the bid is tracked whether or not any provider commits to it
+	if err := tracker.RecordBid(sentBid, time.Now(), mevCommitBlock()); err != nil {
+		log.Printf("Failed to track bid for tx: %s: %v", txHash, err)
+	}
+	commitments, err := bidderClient.SendBidWithContext(ctx, txHashes, amount, blockNumber, decayStart, decayEnd, func(c *pb.Commitment) {
+		if err := tracker.RecordCommitment(sentBid, c); err != nil {
+			log.Printf("Failed to track commitment for tx: %s from provider: %s: %v", txHash, c.ProviderAddress, err)
+		}
+		if err := commitmentVerifier.VerifyCommitment(c, nil); err != nil {
+			log.Printf("Invalid commitment for tx: %s from provider: %s: %v", txHash, c.ProviderAddress, err)
+			return
+		}
+		if bidderAddress != nil {
+			if err := commitmentVerifier.VerifyBid(c, sentBid, *bidderAddress); err != nil {
+				log.Printf("Provider %s committed to a different bid than sent for tx: %s: %v", c.ProviderAddress, txHash, err)
+				return
+			}
+		}
+		log.Printf("Received commitment for tx: %s from provider: %s", txHash, c.ProviderAddress)
+	})
+	if errors.Is(err, bb.ErrBidNotSent) {
 		log.Printf("Failed to send bid: %v", err)
-	} else {
-		log.Printf("Sent preconfirmation bid for tx: %s for block number: %d", txHash, blockNumber)
+		if err := tracker.ForgetBid(sentBid); err != nil {
+			log.Printf("Failed to untrack bid for tx: %s: %v", txHash, err)
+		}
+		return
+	}
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) && status.Code(err) != codes.DeadlineExceeded {
+		log.Printf("Bid stream for tx: %s ended early: %v", txHash, err)
+	}
+	log.Printf("Sent preconfirmation bid of %s wei for tx: %s for block number: %d. Commitments received: %d", amount, txHash, blockNumber, len(commitments))
+}
+
+// mevCommitBlock returns the mev-commit chain head, or 0 if it is unknown.
+func mevCommitBlock() uint64 {
+	if mevCommitClient == nil {
+		return 0
+	}
+	block, err := mevCommitClient.BlockNumber(context.Background())
+	if err != nil {
+		log.Printf("Failed to get mev-commit block number: %v", err)
+		return 0
 	}
+	return block
 }
 
-func checkPendingTxs(client *ethclient.Client, bidderClient *bb.Bidder, pendingTxs map[string]int64, preconfCount map[string]int) {
+// checkPendingTxs resends the preconf bids of the pending txs of sender, replaces the ones pending too long and
+// forgets the ones that landed.
+func checkPendingTxs(client *ethclient.Client, bidderClient *bb.Bidder, strategy bb.BidStrategy, sender *ee.Sender) {
+	pendingTxs, preconfCount := sender.Pending, sender.Bids
+	if dropped, err := ee.Nonces(client, sender.Account.Address).Check(context.Background()); err != nil {
+		log.Printf("Failed to check pending nonce of %s: %v", sender.Account.Address.Hex(), err)
+	} else if dropped {
+		forgetDroppedTxs(client, sender)
+	}
 	for txHash, initialBlock := range pendingTxs {
-		receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash(txHash))
+		landedHash, receipt, err := pendingReceipt(client, txHash)
 		if err != nil {
 			if err == ethereum.NotFound {
 				// Transaction is still pending, resend preconfirmation bid
@@ -115,9 +370,22 @@ func checkPendingTxs(client *ethclient.Client, bidderClient *bb.Bidder, pendingT
 					log.Printf("Failed to retrieve current block number: %v", err)
 					continue
 				}
+				if replaceTx != nil && bumpCount(txHash) < maxBumps && currentBlockNumber >= uint64(initialBlock)+uint64(bumpAfter) {
+					if replacePendingTx(client, bidderClient, strategy, sender, txHash, currentBlockNumber) {
+						continue
+					}
+				}
+				// with a lookahead the last bid may target a later block, keep it until that block is reached
+				if lookahead != nil && bidTargets[txHash] > int64(currentBlockNumber) {
+					continue
+				}
 				if currentBlockNumber > uint64(initialBlock) {
-					sendPreconfBid(bidderClient, txHash, int64(currentBlockNumber)+1)
+					target, ok := targetBlock(int64(currentBlockNumber) + 1)
+					if !ok {
+						continue
+					}
 					preconfCount[txHash]++
+					sendPreconfBid(client, bidderClient, strategy, txHash, len(sender.Txs[txHash].BlobHashes()), target, preconfCount[txHash])
 					log.Printf("Resent preconfirmation bid for tx: %s in block number: %d. Total preconfirmations: %d", txHash, currentBlockNumber, preconfCount[txHash])
 				}
 			} else {
@@ -126,8 +394,197 @@ func checkPendingTxs(client *ethclient.Client, bidderClient *bb.Bidder, pendingT
 		} else {
 			// Transaction is confirmed, remove from pendingTxs
 			delete(pendingTxs, txHash)
-			log.Printf("Transaction %s confirmed in block %d, initially sent in block %d. Total preconfirmations: %d", txHash, receipt.BlockNumber.Uint64(), initialBlock, preconfCount[txHash])
+			log.Printf("Transaction %s confirmed in block %d, initially sent in block %d. Total preconfirmations: %d", landedHash, receipt.BlockNumber.Uint64(), initialBlock, preconfCount[txHash])
 			delete(preconfCount, txHash)
+			delete(bidTargets, txHash)
+			delete(sender.Txs, txHash)
+			delete(replacedTxs, txHash)
+			if err := tracker.RecordInclusion(landedHash, receipt.BlockNumber.Uint64()); err != nil {
+				log.Printf("Failed to track inclusion of tx: %s: %v", landedHash, err)
+			}
+		}
+	}
+}
+
+// poolAccounts authenticates the configured signer, if any, and the accounts in path, one per line, exiting if
+// any of them is invalid. A line is a hex private key, "keystore:<file> [password file]", or "remote:<address>"
+// for an account of the configured remote signer.
+func poolAccounts(cf *commonFlags, client *ethclient.Client, path string) []*bb.AuthAcct {
+	chainID := bb.CurrentNetwork().L1ChainIDBig()
+	if path == "" {
+		return []*bb.AuthAcct{cf.authAcct(client, chainID)}
+	}
+
+	var accounts []*bb.AuthAcct
+	if cf.signer() != nil {
+		accounts = append(accounts, cf.authAcct(client, chainID))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read private keys: %v", err)
+	}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		signer, err := poolSigner(cf, line)
+		if err != nil {
+			log.Fatalf("Invalid account on line %d of %s: %v", i+1, path, err)
+		}
+		authAcct, err := bb.Authenticate(signer, client, chainID)
+		if err != nil {
+			log.Fatalf("Failed to authenticate account on line %d of %s: %v", i+1, path, err)
+		}
+		accounts = append(accounts, authAcct)
+	}
+	if len(accounts) == 0 {
+		log.Fatalf("No private keys in %s", path)
+	}
+	return accounts
+}
+
+// poolSigner returns the signer of a -privatekeys-file line.
+func poolSigner(cf *commonFlags, line string) (bb.Signer, error) {
+	switch {
+	case strings.HasPrefix(line, "keystore:"):
+		fields := strings.Fields(strings.TrimPrefix(line, "keystore:"))
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("expected keystore:<file> [password file]")
+		}
+		keystoreCfg := bb.SignerConfig{Keystore: fields[0]}
+		if len(fields) == 2 {
+			keystoreCfg.PasswordFile = fields[1]
+		}
+		return bb.NewSigner(keystoreCfg, "", promptPassword)
+	case strings.HasPrefix(line, "remote:"):
+		address := strings.TrimSpace(strings.TrimPrefix(line, "remote:"))
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("invalid address %q", address)
+		}
+		remote := cf.config().Signer
+		if remote.RemoteURL == "" {
+			return nil, fmt.Errorf("remote accounts need -remote-signer")
+		}
+		return bb.NewSigner(bb.SignerConfig{RemoteURL: remote.RemoteURL, RemoteMethod: remote.RemoteMethod, Address: address}, "", promptPassword)
+	default:
+		return bb.NewKeySigner(line)
+	}
+}
+
+// topUpAccount authenticates the L1 account of the keystore file that pays for top-ups.
+func topUpAccount(client *ethclient.Client, keystorePath, passwordFile string) *bb.AuthAcct {
+	signer, err := bb.NewSigner(bb.SignerConfig{Keystore: keystorePath, PasswordFile: passwordFile}, "", promptPassword)
+	if err != nil {
+		log.Fatalf("Failed to load top-up account: %v", err)
+	}
+	authAcct, err := bb.Authenticate(signer, client, bb.CurrentNetwork().L1ChainIDBig())
+	if err != nil {
+		log.Fatalf("Failed to authenticate top-up account: %v", err)
+	}
+	return authAcct
+}
+
+// forgetDroppedTxs stops following the pending txs of sender that the node no longer knows, along with every tx
+// they replaced. Their nonces are handed out again.
+func forgetDroppedTxs(client *ethclient.Client, sender *ee.Sender) {
+	for txHash := range sender.Pending {
+		known := false
+		for _, h := range append([]string{txHash}, replacedTxs[txHash]...) {
+			if _, _, err := client.TransactionByHash(context.Background(), common.HexToHash(h)); err != ethereum.NotFound {
+				known = true
+				break
+			}
+		}
+		if known {
+			continue
+		}
+		log.Printf("Transaction %s was dropped from the mempool, no longer following it", txHash)
+		delete(sender.Pending, txHash)
+		delete(sender.Bids, txHash)
+		delete(bidTargets, txHash)
+		delete(sender.Txs, txHash)
+		delete(replacedTxs, txHash)
+	}
+}
+
+// pendingReceipt returns the receipt of txHash or of a tx it replaced, whichever landed, and its hash. It returns
+// ethereum.NotFound while none of them has landed.
+func pendingReceipt(client *ethclient.Client, txHash string) (string, *types.Receipt, error) {
+	for _, h := range append([]string{txHash}, replacedTxs[txHash]...) {
+		receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash(h))
+		if err == nil {
+			return h, receipt, nil
+		}
+		if err != ethereum.NotFound {
+			return "", nil, err
+		}
+	}
+	return "", nil, ethereum.NotFound
+}
+
+// bumpCount returns how many times the fees of the pending txHash were bumped.
+func bumpCount(txHash string) int {
+	return len(replacedTxs[txHash])
+}
+
+// replacePendingTx replaces the pending txHash with a fee-bumped tx, moves its pending state over to the new hash
+// and bids for the new hash. It returns false if the tx could not be replaced.
+func replacePendingTx(client *ethclient.Client, bidderClient *bb.Bidder, strategy bb.BidStrategy, sender *ee.Sender, txHash string, currentBlockNumber uint64) bool {
+	pendingTxs, preconfCount := sender.Pending, sender.Bids
+	tx, ok := sender.Txs[txHash]
+	if !ok {
+		return false
+	}
+	replacement, err := replaceTx(sender.Account, tx)
+	if err != nil {
+		log.Printf("Failed to replace tx: %s: %v", txHash, err)
+		return false
+	}
+	newHash := replacement.Hash().String()
+	log.Printf("Replaced tx %s with %s after %d blocks: tip %s, fee cap %s, blob fee cap %s wei", txHash, newHash, currentBlockNumber-uint64(pendingTxs[txHash]), replacement.GasTipCap(), replacement.GasFeeCap(), replacement.BlobGasFeeCap())
+
+	if err := tracker.RecordReplacement(txHash, newHash); err != nil {
+		log.Printf("Failed to track replacement of tx: %s: %v", txHash, err)
+	}
+
+	pendingTxs[newHash] = int64(currentBlockNumber)
+	preconfCount[newHash] = preconfCount[txHash]
+	sender.Txs[newHash] = replacement
+	replacedTxs[newHash] = append(replacedTxs[txHash], txHash)
+	delete(pendingTxs, txHash)
+	delete(preconfCount, txHash)
+	delete(bidTargets, txHash)
+	delete(sender.Txs, txHash)
+	delete(replacedTxs, txHash)
+
+	// the bids for the old hash can't be honored by the replacement, bid again for the new hash
+	if target, ok := targetBlock(int64(currentBlockNumber) + 1); ok {
+		preconfCount[newHash]++
+		sendPreconfBid(client, bidderClient, strategy, newHash, len(replacement.BlobHashes()), target, preconfCount[newHash])
+	}
+	return true
+}
+
+// verifyInclusions classifies the commitments of confirmed txs once the oracle has recorded the winner of the
+// committed block, which happens some blocks after the tx is confirmed.
+func verifyInclusions() {
+	if mevCommitClient == nil {
+		return
+	}
+	results, err := tracker.VerifyInclusions(mevCommitClient)
+	if err != nil {
+		log.Printf("Failed to verify inclusions: %v", err)
+		return
+	}
+	for _, r := range results {
+		if r.Outcome == bb.InclusionUnresolved {
+			log.Printf("Commitment from provider: %s for tx: %s in block %d is %s: no block winner was recorded",
+				r.Provider, strings.Join(r.TxHashes, ","), r.CommittedBlock, r.Outcome)
+			continue
 		}
+		log.Printf("Commitment from provider: %s for tx: %s in block %d is %s. The tx landed in block %d, block winner: %s",
+			r.Provider, strings.Join(r.TxHashes, ","), r.CommittedBlock, r.Outcome, r.IncludedBlock, r.Winner.Hex())
 	}
 }
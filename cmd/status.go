@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runStatus(args []string) {
+	fs, cf := newFlagSet("status")
+	fs.Parse(args)
+
+	// The mev-commit chain endpoint is optional, it is only used to show the current window
+	if cf.hasMevCommitEndpoint() {
+		client := cf.mevCommitClient()
+		currentWindow, err := bb.WindowHeight(client)
+		if err != nil {
+			log.Fatalf("Failed to get current window: %v", err)
+		}
+		fmt.Printf("Current bidding window: %d\n", currentWindow)
+	}
+
+	bidderClient := cf.bidderClient()
+	ctx := context.Background()
+
+	deposit, err := bidderClient.GetDeposit(ctx, 0)
+	if err != nil {
+		log.Fatalf("Failed to get deposit: %v", err)
+	}
+	fmt.Printf("Deposit in window %d: %s wei\n", deposit.Window, deposit.Amount)
+
+	status, err := bidderClient.AutoDepositStatus(ctx)
+	if err != nil {
+		log.Fatalf("Failed to get auto deposit status: %v", err)
+	}
+	fmt.Printf("Auto deposit enabled: %t\n", status.Enabled)
+	for _, w := range status.Windows {
+		current := ""
+		if w.IsCurrent {
+			current = " (current)"
+		}
+		fmt.Printf("  window %d%s: %s wei, blocks %d-%d\n", w.Window, current, w.Amount, w.StartBlock, w.EndBlock)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/preconf_blob_bidder/core/bridge"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runTopUp(args []string) {
+	fs, cf := newFlagSet("topup")
+	bidder := fs.String("bidder", "", "The mev-commit chain account to keep funded. Defaults to the address of the private key")
+	threshold := fs.String("threshold", "", "Bridge when the bidder balance, minus the deposits still needed for the current and next windows, is below this amount in wei")
+	amount := fs.String("amount", "", "Amount in wei to bridge each time, including the bridge fee")
+	dailyCap := fs.String("daily-cap", "", "Maximum amount in wei to bridge in any 24 hours. Empty for no cap")
+	interval := fs.Duration("interval", time.Minute, "How often to check the bidder balance")
+	statePath := fs.String("state", "data/topup.json", "File to keep the initiated transfers in")
+	fs.Parse(args)
+
+	enableGethLogs()
+
+	l1Client := cf.l1Client()
+	authAcct := cf.authAcct(l1Client, bb.CurrentNetwork().L1ChainIDBig())
+	bidderAccount := authAcct.Address
+	if *bidder != "" {
+		if !common.IsHexAddress(*bidder) {
+			log.Fatalf("Invalid bidder address: %s", *bidder)
+		}
+		bidderAccount = common.HexToAddress(*bidder)
+	}
+
+	topUp := newTopUp(l1Client, cf.mevCommitClient(), authAcct, bridge.TopUpConfig{
+		Bidder:    bidderAccount,
+		Threshold: parseWei("threshold", *threshold, true),
+		Amount:    parseWei("amount", *amount, true),
+		DailyCap:  parseWei("daily-cap", *dailyCap, false),
+		Interval:  *interval,
+		StatePath: *statePath,
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := topUp.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatalf("Top-up watcher stopped: %v", err)
+	}
+}
+
+// newTopUp creates the top-up watcher bridging from the L1 account of authAcct, exiting if cfg is invalid.
+func newTopUp(l1Client, mevCommitClient *ethclient.Client, authAcct *bb.AuthAcct, cfg bridge.TopUpConfig) *bridge.TopUp {
+	topUp, err := bridge.NewTopUp(bridge.New(l1Client, mevCommitClient), authAcct, cfg)
+	if err != nil {
+		log.Fatalf("Failed to create top-up watcher: %v", err)
+	}
+	return topUp
+}
+
+// parseWei parses the wei amount of flag name. An empty optional amount gives nil.
+func parseWei(name, s string, required bool) *big.Int {
+	if s == "" {
+		if required {
+			log.Fatalf("The -%s flag is required.", name)
+		}
+		return nil
+	}
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok || value.Sign() < 0 {
+		log.Fatalf("Invalid -%s amount in wei: %s", name, s)
+	}
+	return value
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runTrack(args []string) {
+	fs, cf := newFlagSet("track")
+	trackerPath := fs.String("tracker", "data/tracker.json", "File the bids and their commitments are tracked in")
+	txHash := fs.String("tx", "", "Show what happened to the bids for this tx hash")
+	follow := fs.Bool("follow", false, "Keep following the commitment events on the mev-commit chain. Don't use while the blob command runs with -follow")
+	verify := fs.Bool("verify", false, "Check the commitments of confirmed txs against the block winners on the mev-commit chain first")
+	fs.Parse(args)
+
+	if *txHash == "" && !*follow && !*verify {
+		log.Fatal("Use -tx to show the bids for a tx, -verify to check inclusions or -follow to follow commitment events.")
+	}
+
+	tracker, err := bb.NewTracker(*trackerPath)
+	if err != nil {
+		log.Fatalf("Failed to load tracker: %v", err)
+	}
+
+	if *verify {
+		results, err := tracker.VerifyInclusions(cf.mevCommitClient())
+		if err != nil {
+			log.Fatalf("Failed to verify inclusions: %v", err)
+		}
+		fmt.Printf("Classified %d commitments\n", len(results))
+	}
+
+	if *follow {
+		enableGethLogs()
+		endpoint := cf.mevCommitEndpoint()
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		fmt.Println("Following commitment events, press Ctrl+C to stop")
+		if err := tracker.Follow(ctx, endpoint); err != nil && !errors.Is(err, context.Canceled) {
+			log.Fatalf("Failed to follow commitment events: %v", err)
+		}
+	}
+
+	if *txHash != "" {
+		printBids(*txHash, tracker.BidsForTx(*txHash))
+	}
+}
+
+func printBids(txHash string, bids []bb.BidRecord) {
+	if len(bids) == 0 {
+		fmt.Printf("No bids tracked for tx %s\n", txHash)
+		return
+	}
+
+	for _, bid := range bids {
+		fmt.Printf("Bid %s of %s wei for block %d, sent %s: %s", bid.BidDigest, bid.Amount, bid.BlockNumber, formatUnixMilli(bid.SentAt), bid.State())
+		if bid.IncludedBlock != 0 {
+			fmt.Printf(", tx landed in block %d", bid.IncludedBlock)
+		}
+		if bid.ReplacedBy != "" {
+			fmt.Printf(", tx %s replaced by %s", strings.Join(bid.TxHashes, ","), bid.ReplacedBy)
+		}
+		fmt.Println()
+		for _, c := range bid.Commitments {
+			fmt.Printf("  Commitment %s from provider %s: %s", c.Digest, c.Provider, c.State)
+			if c.BlockWinner != "" {
+				fmt.Printf(", %s (block winner %s)", c.Inclusion, c.BlockWinner)
+			} else if c.Inclusion != "" {
+				fmt.Printf(", %s (no block winner recorded)", c.Inclusion)
+			}
+			if c.Slashed {
+				fmt.Print(", slashed")
+			}
+			if c.Settlement != "" {
+				fmt.Printf(", %s %s wei in window %d", c.Settlement, c.SettledAmount, c.Window)
+			}
+			fmt.Println()
+			for _, tr := range c.History {
+				fmt.Printf("    %-20s %s", tr.State, formatUnixMilli(tr.Timestamp))
+				if tr.TxHash != "" {
+					fmt.Printf(" block %d tx %s", tr.Block, tr.TxHash)
+				}
+				fmt.Println()
+			}
+		}
+	}
+}
+
+func formatUnixMilli(ts int64) string {
+	return time.UnixMilli(ts).Format(time.RFC3339)
+}
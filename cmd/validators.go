@@ -0,0 +1,138 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+func runValidators(args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "Usage: validators <list|status|stake|unstake|withdraw> [flags]")
+		os.Exit(2)
+	}
+
+	switch args[0] {
+	case "list":
+		runValidatorsList(args[1:])
+	case "status":
+		runValidatorsStatus(args[1:])
+	case "stake", "unstake", "withdraw":
+		runValidatorsTx(args[0], args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown validators command %q. Use list, status, stake, unstake or withdraw.\n", args[0])
+		os.Exit(2)
+	}
+}
+
+func runValidatorsList(args []string) {
+	fs, cf := newFlagSet("validators list")
+	pageSize := fs.Uint64("page-size", 100, "Number of validators to read per getStakedValidators call")
+	fs.Parse(args)
+
+	keys, version, err := bb.StakedValidators(cf.l1Client(), *pageSize)
+	if err != nil {
+		log.Fatalf("Failed to list staked validators: %v", err)
+	}
+
+	for _, key := range keys {
+		fmt.Println(hexutil.Encode(key))
+	}
+	fmt.Fprintf(os.Stderr, "%d staked validators, staked set version %s\n", len(keys), version)
+}
+
+func runValidatorsStatus(args []string) {
+	fs, cf := newFlagSet("validators status")
+	pubkeys := validatorPubkeysFlags(fs)
+	fs.Parse(args)
+
+	keys := pubkeys.parse()
+	statuses, err := bb.ValidatorStatuses(cf.l1Client(), keys)
+	if err != nil {
+		log.Fatalf("Failed to get validator status: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PUBKEY\tSTAKED\tSTAKED AMOUNT (wei)\tUNSTAKING AMOUNT (wei)\tBLOCKS TILL WITHDRAW")
+	for _, s := range statuses {
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\t%s\n", hexutil.Encode(s.Pubkey), s.Staked, s.StakedAmount, s.UnstakingAmount, s.BlocksTillWithdrawAllowed)
+	}
+	w.Flush()
+}
+
+func runValidatorsTx(action string, args []string) {
+	fs, cf := newFlagSet("validators " + action)
+	pubkeys := validatorPubkeysFlags(fs)
+	amount := fs.String("amount", "", "Amount in wei to stake per validator. Required for stake")
+	fs.Parse(args)
+
+	keys := pubkeys.parse()
+	client := cf.l1Client()
+	authAcct := cf.authAcct(client, bb.CurrentNetwork().L1ChainIDBig())
+
+	var err error
+	switch action {
+	case "stake":
+		stake, ok := new(big.Int).SetString(*amount, 10)
+		if !ok || stake.Sign() <= 0 {
+			log.Fatal("Amount is required for stake. Use the -amount flag to provide it in wei.")
+		}
+		_, err = bb.StakeValidators(client, authAcct, keys, stake)
+	case "unstake":
+		_, err = bb.UnstakeValidators(client, authAcct, keys)
+	case "withdraw":
+		_, err = bb.WithdrawValidators(client, authAcct, keys)
+	}
+	if err != nil {
+		log.Fatalf("Failed to %s validators: %v", action, err)
+	}
+	fmt.Printf("%d validators: %s successful\n", len(keys), action)
+}
+
+// validatorPubkeys holds the flags selecting the BLS keys a validators command works on.
+type validatorPubkeys struct {
+	list *string
+	file *string
+}
+
+func validatorPubkeysFlags(fs *flag.FlagSet) validatorPubkeys {
+	return validatorPubkeys{
+		list: fs.String("pubkeys", "", "Comma separated BLS pubkeys"),
+		file: fs.String("pubkeys-file", "", "File with one BLS pubkey per line"),
+	}
+}
+
+// parse decodes the selected keys, exiting if none is given or one is invalid.
+func (p validatorPubkeys) parse() [][]byte {
+	values := strings.Split(*p.list, ",")
+	if *p.file != "" {
+		data, err := os.ReadFile(*p.file)
+		if err != nil {
+			log.Fatalf("Failed to read pubkeys file: %v", err)
+		}
+		values = append(values, strings.Split(string(data), "\n")...)
+	}
+
+	var keys [][]byte
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		key, err := bb.ParseBLSPubkey(value)
+		if err != nil {
+			log.Fatal(err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		log.Fatal("At least one pubkey is required. Use -pubkeys or -pubkeys-file to provide them.")
+	}
+	return keys
+}
@@ -0,0 +1,112 @@
+// Package beacon is a minimal client for the beacon node API endpoints the bidder needs to know who proposes
+// upcoming slots, and a stand-in server implementing them for offline testing.
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SlotsPerEpoch is the number of slots in an epoch on every Ethereum network.
+const SlotsPerEpoch = 32
+
+// ProposerDuty is the validator scheduled to propose the block of a slot.
+type ProposerDuty struct {
+	// Pubkey is the validator's BLS public key, hex encoded with 0x prefix.
+	Pubkey         string
+	ValidatorIndex uint64
+	Slot           uint64
+}
+
+// Client talks to the standard beacon node API at URL.
+type Client struct {
+	URL  string
+	HTTP *http.Client
+}
+
+// NewClient creates a client for the beacon node API at url, e.g. http://localhost:5052.
+func NewClient(url string) *Client {
+	return &Client{
+		URL:  strings.TrimSuffix(url, "/"),
+		HTTP: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// HeadSlot returns the slot of the chain head.
+func (c *Client) HeadSlot(ctx context.Context) (uint64, error) {
+	var resp struct {
+		Data struct {
+			Header struct {
+				Message struct {
+					Slot string `json:"slot"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "/eth/v1/beacon/headers/head", &resp); err != nil {
+		return 0, err
+	}
+
+	slot, err := strconv.ParseUint(resp.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid head slot %q: %v", resp.Data.Header.Message.Slot, err)
+	}
+	return slot, nil
+}
+
+// ProposerDuties returns the proposers of every slot in epoch. Beacon nodes serve the current and next epoch.
+func (c *Client) ProposerDuties(ctx context.Context, epoch uint64) ([]ProposerDuty, error) {
+	var resp struct {
+		Data []struct {
+			Pubkey         string `json:"pubkey"`
+			ValidatorIndex string `json:"validator_index"`
+			Slot           string `json:"slot"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), &resp); err != nil {
+		return nil, err
+	}
+
+	duties := make([]ProposerDuty, 0, len(resp.Data))
+	for _, d := range resp.Data {
+		slot, err := strconv.ParseUint(d.Slot, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duty slot %q: %v", d.Slot, err)
+		}
+		index, err := strconv.ParseUint(d.ValidatorIndex, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duty validator index %q: %v", d.ValidatorIndex, err)
+		}
+		duties = append(duties, ProposerDuty{Pubkey: d.Pubkey, ValidatorIndex: index, Slot: slot})
+	}
+	return duties, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL+path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request to %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned %s: %s", path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response of %s: %v", path, err)
+	}
+	return nil
+}
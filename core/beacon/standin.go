@@ -0,0 +1,83 @@
+package beacon
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SecondsPerSlot is the slot time of every Ethereum network.
+const SecondsPerSlot = 12
+
+// StandIn serves the head and proposer duties endpoints of the beacon node API without a beacon node, so the
+// proposer lookahead can be tried offline. Slots are derived from GenesisTime and proposers are assigned from
+// Pubkeys round robin by slot.
+type StandIn struct {
+	Pubkeys     []string
+	GenesisTime time.Time
+}
+
+// ServeHTTP implements http.Handler.
+func (s *StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/eth/v1/beacon/headers/head":
+		var resp struct {
+			Data struct {
+				Header struct {
+					Message struct {
+						Slot string `json:"slot"`
+					} `json:"message"`
+				} `json:"header"`
+			} `json:"data"`
+		}
+		resp.Data.Header.Message.Slot = strconv.FormatUint(s.headSlot(), 10)
+		writeJSON(w, resp)
+
+	case strings.HasPrefix(r.URL.Path, "/eth/v1/validator/duties/proposer/"):
+		epoch, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/eth/v1/validator/duties/proposer/"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid epoch", http.StatusBadRequest)
+			return
+		}
+		if len(s.Pubkeys) == 0 {
+			http.Error(w, "no validators", http.StatusServiceUnavailable)
+			return
+		}
+
+		type duty struct {
+			Pubkey         string `json:"pubkey"`
+			ValidatorIndex string `json:"validator_index"`
+			Slot           string `json:"slot"`
+		}
+		var resp struct {
+			Data []duty `json:"data"`
+		}
+		for slot := epoch * SlotsPerEpoch; slot < (epoch+1)*SlotsPerEpoch; slot++ {
+			index := slot % uint64(len(s.Pubkeys))
+			resp.Data = append(resp.Data, duty{
+				Pubkey:         s.Pubkeys[index],
+				ValidatorIndex: strconv.FormatUint(index, 10),
+				Slot:           strconv.FormatUint(slot, 10),
+			})
+		}
+		writeJSON(w, resp)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *StandIn) headSlot() uint64 {
+	elapsed := time.Since(s.GenesisTime)
+	if elapsed < 0 {
+		return 0
+	}
+	return uint64(elapsed / (SecondsPerSlot * time.Second))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,321 @@
+// Package bridge moves ETH between L1 and the mev-commit chain through the L1Gateway and SettlementGateway
+// contracts. A transfer is initiated on one side and finalized by the relayer on the other, which emits
+// TransferFinalized with the index of the initiated transfer as counterpartyIdx.
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+// Direction is the way a transfer crosses the bridge.
+type Direction string
+
+const (
+	// ToMevCommit moves ETH from L1 to the mev-commit chain through L1Gateway.
+	ToMevCommit Direction = "mev-commit"
+	// ToL1 moves ETH from the mev-commit chain to L1 through SettlementGateway.
+	ToL1 Direction = "l1"
+)
+
+// ParseDirection parses the destination chain name of a transfer, "mev-commit" or "l1".
+func ParseDirection(s string) (Direction, error) {
+	switch d := Direction(s); d {
+	case ToMevCommit, ToL1:
+		return d, nil
+	default:
+		return "", fmt.Errorf("invalid bridge direction %q, use %s or %s", s, ToMevCommit, ToL1)
+	}
+}
+
+var (
+	// ErrTimeout is returned when a transfer is not finalized before the context deadline.
+	ErrTimeout = errors.New("transfer not finalized before timeout")
+	// ErrTransferFailed is returned when the initiateTransfer tx of a transfer will never initiate it.
+	ErrTransferFailed = errors.New("transfer failed")
+)
+
+// Quote is what a transfer of Amount costs and delivers.
+type Quote struct {
+	Direction Direction
+	Amount    *big.Int
+	// CounterpartyFee is the source gateway's minimum amount, set to cover finalizing on the other side.
+	CounterpartyFee *big.Int
+	// FinalizationFee is what the destination gateway keeps when finalizing. It matches CounterpartyFee
+	// when the gateways are configured consistently.
+	FinalizationFee *big.Int
+	// Received is what the recipient gets on the destination chain: Amount minus FinalizationFee.
+	Received *big.Int
+}
+
+// Transfer is an initiated transfer.
+type Transfer struct {
+	Direction Direction      `json:"direction"`
+	Sender    common.Address `json:"sender"`
+	Recipient common.Address `json:"recipient"`
+	Amount    *big.Int       `json:"amount"`
+	// TransferIdx is nil until the initiateTransfer tx TxHash is mined.
+	TransferIdx *big.Int    `json:"transferIdx"`
+	TxHash      common.Hash `json:"txHash"`
+	// DestinationBlock is the destination chain head when the transfer was initiated. The TransferFinalized
+	// event can't be earlier.
+	DestinationBlock uint64 `json:"destinationBlock"`
+}
+
+// Finalized is the TransferFinalized event of a transfer on the destination chain.
+type Finalized struct {
+	Amount *big.Int
+	TxHash common.Hash
+	Block  uint64
+}
+
+// Progress reports the stage of a transfer.
+type Progress struct {
+	Stage    string
+	Transfer *Transfer
+	Elapsed  time.Duration
+	// DestinationBlock is the destination chain head last checked while waiting.
+	DestinationBlock uint64
+	// Err is set when checking the destination chain failed. The check is retried until the timeout.
+	Err error
+}
+
+// Progress stages.
+const (
+	StageInitiated = "initiated"
+	StageWaiting   = "waiting"
+	StageFinalized = "finalized"
+)
+
+// Bridge sends transfers between L1 and the mev-commit chain of the current network.
+type Bridge struct {
+	L1        *ethclient.Client
+	MevCommit *ethclient.Client
+	// PollInterval is how often the destination chain is checked for the TransferFinalized event.
+	PollInterval time.Duration
+}
+
+// New creates a bridge between the L1 and mev-commit chain clients.
+func New(l1, mevCommit *ethclient.Client) *Bridge {
+	return &Bridge{L1: l1, MevCommit: mevCommit, PollInterval: 6 * time.Second}
+}
+
+// Quote reads the fees of a transfer of amount in direction.
+func (b *Bridge) Quote(dir Direction, amount *big.Int) (*Quote, error) {
+	src, dst, err := b.sides(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyFee, err := src.gateway.CounterpartyFee(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call counterpartyFee function on %s: %v", src.name, err)
+	}
+	finalizationFee, err := dst.gateway.FinalizationFee(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call finalizationFee function on %s: %v", dst.name, err)
+	}
+	// the source gateway accepts any amount that covers its counterparty fee
+	if amount.Cmp(counterpartyFee) < 0 {
+		return nil, fmt.Errorf("amount %s wei is below the counterparty fee of %s wei", amount, counterpartyFee)
+	}
+	// the destination gateway keeps its own finalization fee out of the amount
+	if amount.Cmp(finalizationFee) < 0 {
+		return nil, fmt.Errorf("amount %s wei is below the finalization fee of %s wei", amount, finalizationFee)
+	}
+
+	return &Quote{
+		Direction:       dir,
+		Amount:          new(big.Int).Set(amount),
+		CounterpartyFee: counterpartyFee,
+		FinalizationFee: finalizationFee,
+		Received:        new(big.Int).Sub(amount, finalizationFee),
+	}, nil
+}
+
+// InitiateTransfer calls initiateTransfer on the source gateway of dir and waits for it to be mined. authAcct
+// must sign for the source chain.
+func (b *Bridge) InitiateTransfer(ctx context.Context, dir Direction, authAcct *bb.AuthAcct, recipient common.Address, amount *big.Int) (*Transfer, error) {
+	t, err := b.StartTransfer(ctx, dir, authAcct, recipient, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		mined, err := b.ResolveTransfer(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		if mined {
+			return t, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("initiateTransfer transaction %s not mined: %v", t.TxHash.Hex(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// StartTransfer sends the initiateTransfer tx on the source gateway of dir without waiting for it to be mined.
+// The transfer has no TransferIdx until ResolveTransfer finds the tx mined, so callers can save it before
+// waiting. authAcct must sign for the source chain.
+func (b *Bridge) StartTransfer(ctx context.Context, dir Direction, authAcct *bb.AuthAcct, recipient common.Address, amount *big.Int) (*Transfer, error) {
+	src, dst, err := b.sides(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	destinationBlock, err := dst.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s block number: %v", dst.name, err)
+	}
+
+	opts := *authAcct.Auth
+	opts.Context = ctx
+	opts.Value = amount
+	tx, err := src.gateway.InitiateTransfer(&opts, recipient, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create initiateTransfer transaction: %v", err)
+	}
+
+	return &Transfer{
+		Direction:        dir,
+		Sender:           authAcct.Address,
+		Recipient:        recipient,
+		Amount:           new(big.Int).Set(amount),
+		TxHash:           tx.Hash(),
+		DestinationBlock: destinationBlock,
+	}, nil
+}
+
+// ResolveTransfer sets the TransferIdx of t from the receipt of its initiateTransfer tx. It returns false if the
+// tx is still pending, and an error wrapping ErrTransferFailed if it reverted or is no longer known to the node.
+func (b *Bridge) ResolveTransfer(ctx context.Context, t *Transfer) (bool, error) {
+	src, _, err := b.sides(t.Direction)
+	if err != nil {
+		return false, err
+	}
+
+	receipt, err := src.client.TransactionReceipt(ctx, t.TxHash)
+	if errors.Is(err, ethereum.NotFound) {
+		if _, _, err := src.client.TransactionByHash(ctx, t.TxHash); errors.Is(err, ethereum.NotFound) {
+			return false, fmt.Errorf("%w: initiateTransfer transaction %s was dropped", ErrTransferFailed, t.TxHash.Hex())
+		} else if err != nil {
+			return false, fmt.Errorf("failed to get initiateTransfer transaction: %v", err)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get initiateTransfer receipt: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return false, fmt.Errorf("%w: initiateTransfer transaction %s reverted", ErrTransferFailed, t.TxHash.Hex())
+	}
+
+	for _, l := range receipt.Logs {
+		if idx, ok := src.transferIdx(l); ok {
+			t.TransferIdx = idx
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%w: no TransferInitiated event in transaction %s", ErrTransferFailed, t.TxHash.Hex())
+}
+
+// WaitFinalized waits for the TransferFinalized event of t on the destination chain, calling progress, if not
+// nil, after every check. It returns ErrTimeout when ctx expires first.
+func (b *Bridge) WaitFinalized(ctx context.Context, t *Transfer, progress func(Progress)) (*Finalized, error) {
+	_, dst, err := b.sides(t.Direction)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	from := t.DestinationBlock
+	ticker := time.NewTicker(b.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		head, err := dst.client.BlockNumber(ctx)
+		if err == nil && head >= from {
+			var finalized *Finalized
+			var scanned uint64
+			finalized, scanned, err = dst.scanFinalized(ctx, from, head, t)
+			if err == nil && finalized != nil {
+				if progress != nil {
+					progress(Progress{Stage: StageFinalized, Transfer: t, Elapsed: time.Since(start), DestinationBlock: finalized.Block})
+				}
+				return finalized, nil
+			}
+			from = scanned + 1
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to check %s for TransferFinalized: %v", dst.name, err)
+		}
+		if progress != nil && ctx.Err() == nil {
+			progress(Progress{Stage: StageWaiting, Transfer: t, Elapsed: time.Since(start), DestinationBlock: head, Err: err})
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%w: transfer %s after %s", ErrTimeout, t.TransferIdx, time.Since(start).Round(time.Second))
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckFinalized returns the TransferFinalized event of t if the destination chain has it, or nil if not yet.
+// Only blocks after scannedTo are searched, from t.DestinationBlock on, and the last block searched is returned
+// so the next check can continue from there, even when it fails part way.
+func (b *Bridge) CheckFinalized(ctx context.Context, t *Transfer, scannedTo uint64) (*Finalized, uint64, error) {
+	_, dst, err := b.sides(t.Direction)
+	if err != nil {
+		return nil, scannedTo, err
+	}
+
+	from := scannedTo + 1
+	if from < t.DestinationBlock {
+		from = t.DestinationBlock
+	}
+	head, err := dst.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, scannedTo, fmt.Errorf("failed to get %s block number: %v", dst.name, err)
+	}
+	if head < from {
+		return nil, scannedTo, nil
+	}
+	return dst.scanFinalized(ctx, from, head, t)
+}
+
+// Send quotes, initiates and waits for a transfer, reporting each stage to progress if not nil.
+func (b *Bridge) Send(ctx context.Context, dir Direction, authAcct *bb.AuthAcct, recipient common.Address, amount *big.Int, progress func(Progress)) (*Transfer, *Finalized, error) {
+	if _, err := b.Quote(dir, amount); err != nil {
+		return nil, nil, err
+	}
+
+	t, err := b.InitiateTransfer(ctx, dir, authAcct, recipient, amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	if progress != nil {
+		progress(Progress{Stage: StageInitiated, Transfer: t})
+	}
+
+	finalized, err := b.WaitFinalized(ctx, t, progress)
+	return t, finalized, err
+}
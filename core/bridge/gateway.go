@@ -0,0 +1,148 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+// gateway is the part of the L1Gateway and SettlementGateway bindings the bridge calls. Both contracts share
+// the same Gateway base.
+type gateway interface {
+	CounterpartyFee(opts *bind.CallOpts) (*big.Int, error)
+	FinalizationFee(opts *bind.CallOpts) (*big.Int, error)
+	InitiateTransfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// side is one chain of the bridge and its gateway.
+type side struct {
+	name    string
+	client  *ethclient.Client
+	gateway gateway
+	// transferIdx returns the transfer index of a TransferInitiated log of this gateway.
+	transferIdx func(l *types.Log) (*big.Int, bool)
+	// findFinalized returns the TransferFinalized event for counterpartyIdx between from and to, or nil.
+	findFinalized func(ctx context.Context, from, to uint64, recipient common.Address, counterpartyIdx *big.Int) (*Finalized, error)
+}
+
+// maxLogRange is the largest block range requested in a single eth_getLogs call. Public RPCs reject larger ones.
+const maxLogRange = 5000
+
+// scanFinalized looks for the TransferFinalized event of t between from and to, maxLogRange blocks at a time. It
+// returns the event, or nil if there is none, and the last block scanned, which is from-1 if nothing was.
+func (s *side) scanFinalized(ctx context.Context, from, to uint64, t *Transfer) (*Finalized, uint64, error) {
+	scanned := from - 1
+	for start := from; start <= to; start += maxLogRange {
+		end := start + maxLogRange - 1
+		if end > to {
+			end = to
+		}
+		finalized, err := s.findFinalized(ctx, start, end, t.Recipient, t.TransferIdx)
+		if err != nil || finalized != nil {
+			return finalized, scanned, err
+		}
+		scanned = end
+	}
+	return nil, scanned, nil
+}
+
+// sides returns the source and destination of a transfer in dir.
+func (b *Bridge) sides(dir Direction) (src, dst *side, err error) {
+	l1, err := b.l1Side()
+	if err != nil {
+		return nil, nil, err
+	}
+	mevCommit, err := b.mevCommitSide()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch dir {
+	case ToMevCommit:
+		return l1, mevCommit, nil
+	case ToL1:
+		return mevCommit, l1, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid bridge direction %q", dir)
+	}
+}
+
+func (b *Bridge) l1Side() (*side, error) {
+	l1Gateway, err := bb.L1GatewayContract(b.L1)
+	if err != nil {
+		return nil, err
+	}
+	address := bb.CurrentNetwork().Contracts.L1Gateway
+
+	return &side{
+		name:    "L1Gateway",
+		client:  b.L1,
+		gateway: l1Gateway,
+		transferIdx: func(l *types.Log) (*big.Int, bool) {
+			if l.Address != address {
+				return nil, false
+			}
+			ev, err := l1Gateway.ParseTransferInitiated(*l)
+			if err != nil {
+				return nil, false
+			}
+			return ev.TransferIdx, true
+		},
+		findFinalized: func(ctx context.Context, from, to uint64, recipient common.Address, counterpartyIdx *big.Int) (*Finalized, error) {
+			it, err := l1Gateway.FilterTransferFinalized(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, []common.Address{recipient}, []*big.Int{counterpartyIdx})
+			if err != nil {
+				return nil, err
+			}
+			defer it.Close()
+			for it.Next() {
+				if !it.Event.Raw.Removed {
+					return &Finalized{Amount: it.Event.Amount, TxHash: it.Event.Raw.TxHash, Block: it.Event.Raw.BlockNumber}, nil
+				}
+			}
+			return nil, it.Error()
+		},
+	}, nil
+}
+
+func (b *Bridge) mevCommitSide() (*side, error) {
+	settlementGateway, err := bb.SettlementGatewayContract(b.MevCommit)
+	if err != nil {
+		return nil, err
+	}
+	address := bb.CurrentNetwork().Contracts.SettlementGateway
+
+	return &side{
+		name:    "SettlementGateway",
+		client:  b.MevCommit,
+		gateway: settlementGateway,
+		transferIdx: func(l *types.Log) (*big.Int, bool) {
+			if l.Address != address {
+				return nil, false
+			}
+			ev, err := settlementGateway.ParseTransferInitiated(*l)
+			if err != nil {
+				return nil, false
+			}
+			return ev.TransferIdx, true
+		},
+		findFinalized: func(ctx context.Context, from, to uint64, recipient common.Address, counterpartyIdx *big.Int) (*Finalized, error) {
+			it, err := settlementGateway.FilterTransferFinalized(&bind.FilterOpts{Start: from, End: &to, Context: ctx}, []common.Address{recipient}, []*big.Int{counterpartyIdx})
+			if err != nil {
+				return nil, err
+			}
+			defer it.Close()
+			for it.Next() {
+				if !it.Event.Raw.Removed {
+					return &Finalized{Amount: it.Event.Amount, TxHash: it.Event.Raw.TxHash, Block: it.Event.Raw.BlockNumber}, nil
+				}
+			}
+			return nil, it.Error()
+		},
+	}, nil
+}
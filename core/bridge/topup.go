@@ -0,0 +1,223 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+	"github.com/primev/preconf_blob_bidder/core/store"
+)
+
+// TopUpConfig holds the settings of a TopUp watcher.
+type TopUpConfig struct {
+	// Bidder is the mev-commit chain account to keep funded.
+	Bidder common.Address
+	// Threshold is the balance, after setting aside the deposits still needed for the current and next
+	// windows, below which a transfer is started.
+	Threshold *big.Int
+	// Amount is the amount bridged by each transfer, including the bridge fee.
+	Amount *big.Int
+	// DailyCap limits the amount bridged in any 24 hours. Nil or zero means no cap.
+	DailyCap *big.Int
+	// Interval is how often the balance is checked.
+	Interval time.Duration
+	// StatePath is the JSON file the watcher keeps its transfers in, so the cap and the in-flight transfer
+	// survive restarts.
+	StatePath string
+}
+
+// TopUpRecord is a transfer started by the watcher. It is saved as soon as the initiateTransfer tx is sent.
+type TopUpRecord struct {
+	Transfer    *Transfer `json:"transfer"`
+	InitiatedAt int64     `json:"initiatedAt"`
+	FinalizedAt int64     `json:"finalizedAt,omitempty"`
+	FinalizedTx string    `json:"finalizedTx,omitempty"`
+	// Failed is why the initiateTransfer tx never initiated the transfer.
+	Failed string `json:"failed,omitempty"`
+	// ScannedTo is the last destination chain block searched for the TransferFinalized event.
+	ScannedTo uint64 `json:"scannedTo,omitempty"`
+}
+
+type topUpState struct {
+	Transfers []*TopUpRecord `json:"transfers"`
+}
+
+// TopUp keeps the bidder's mev-commit chain balance funded by bridging from L1. At most one transfer is in flight
+// at a time; until it is finalized no other transfer is started, however long it takes.
+type TopUp struct {
+	bridge   *Bridge
+	authAcct *bb.AuthAcct
+	cfg      TopUpConfig
+	state    topUpState
+}
+
+// NewTopUp creates a watcher that bridges from the L1 account of authAcct, loading any saved state.
+func NewTopUp(b *Bridge, authAcct *bb.AuthAcct, cfg TopUpConfig) (*TopUp, error) {
+	if cfg.Threshold == nil || cfg.Amount == nil || cfg.Amount.Sign() <= 0 {
+		return nil, fmt.Errorf("top-up threshold and amount are required")
+	}
+	if cfg.DailyCap != nil && cfg.DailyCap.Sign() > 0 && cfg.Amount.Cmp(cfg.DailyCap) > 0 {
+		return nil, fmt.Errorf("top-up amount %s wei is above the daily cap of %s wei", cfg.Amount, cfg.DailyCap)
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = time.Minute
+	}
+	if cfg.StatePath == "" {
+		cfg.StatePath = "data/topup.json"
+	}
+
+	t := &TopUp{bridge: b, authAcct: authAcct, cfg: cfg}
+	if err := store.ReadJSON(cfg.StatePath, &t.state); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Run checks the balance every Interval until ctx is cancelled.
+func (t *TopUp) Run(ctx context.Context) error {
+	ticker := time.NewTicker(t.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.check(ctx); err != nil {
+			log.Error("Top-up check failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// check follows the in-flight transfer until it is finalized, and otherwise starts one if the balance is low.
+func (t *TopUp) check(ctx context.Context) error {
+	if pending := t.inFlight(); pending != nil {
+		if pending.Transfer.TransferIdx == nil {
+			mined, err := t.bridge.ResolveTransfer(ctx, pending.Transfer)
+			if errors.Is(err, ErrTransferFailed) {
+				log.Warn("Top-up transfer failed", "tx", pending.Transfer.TxHash, "error", err)
+				pending.Failed = err.Error()
+				return store.WriteJSON(t.cfg.StatePath, t.state)
+			}
+			if err != nil {
+				return err
+			}
+			if !mined {
+				log.Info("Top-up transfer not mined yet", "tx", pending.Transfer.TxHash, "since", time.Unix(pending.InitiatedAt, 0))
+				return nil
+			}
+			log.Info("Top-up transfer initiated", "transferIdx", pending.Transfer.TransferIdx, "tx", pending.Transfer.TxHash)
+			if err := store.WriteJSON(t.cfg.StatePath, t.state); err != nil {
+				return err
+			}
+		}
+
+		scannedTo := pending.ScannedTo
+		finalized, scanned, err := t.bridge.CheckFinalized(ctx, pending.Transfer, scannedTo)
+		if scanned != scannedTo {
+			pending.ScannedTo = scanned
+			if err := store.WriteJSON(t.cfg.StatePath, t.state); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if finalized == nil {
+			log.Info("Top-up transfer still in flight", "transferIdx", pending.Transfer.TransferIdx, "since", time.Unix(pending.InitiatedAt, 0))
+			return nil
+		}
+		pending.FinalizedAt = time.Now().Unix()
+		pending.FinalizedTx = finalized.TxHash.Hex()
+		log.Info("Top-up transfer finalized", "transferIdx", pending.Transfer.TransferIdx, "tx", finalized.TxHash)
+		return store.WriteJSON(t.cfg.StatePath, t.state)
+	}
+
+	available, needed, err := t.available(ctx)
+	if err != nil {
+		return err
+	}
+	if available.Cmp(t.cfg.Threshold) >= 0 {
+		return nil
+	}
+
+	bridged := t.bridgedSince(time.Now().Add(-24 * time.Hour))
+	if cap := t.cfg.DailyCap; cap != nil && cap.Sign() > 0 && new(big.Int).Add(bridged, t.cfg.Amount).Cmp(cap) > 0 {
+		log.Warn("Bidder balance is low but the daily top-up cap is reached", "available", available, "bridged24h", bridged, "cap", cap)
+		return nil
+	}
+
+	log.Info("Bidder balance is low, bridging from L1", "available", available, "neededDeposits", needed, "threshold", t.cfg.Threshold, "amount", t.cfg.Amount)
+	if _, err := t.bridge.Quote(ToMevCommit, t.cfg.Amount); err != nil {
+		return err
+	}
+	transfer, err := t.bridge.StartTransfer(ctx, ToMevCommit, t.authAcct, t.cfg.Bidder, t.cfg.Amount)
+	if err != nil {
+		return err
+	}
+	log.Info("Top-up transfer sent", "tx", transfer.TxHash)
+
+	// saved before it is mined, so a restart picks it up instead of sending a second transfer
+	t.state.Transfers = append(t.state.Transfers, &TopUpRecord{Transfer: transfer, InitiatedAt: time.Now().Unix()})
+	return store.WriteJSON(t.cfg.StatePath, t.state)
+}
+
+// available returns the bidder's balance minus the deposits still needed to reach the minimum deposit in the
+// current and next windows, and those deposits.
+func (t *TopUp) available(ctx context.Context) (*big.Int, *big.Int, error) {
+	client := t.bridge.MevCommit
+
+	balance, err := client.BalanceAt(ctx, t.cfg.Bidder, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get bidder balance: %v", err)
+	}
+	currentWindow, err := bb.WindowHeight(client)
+	if err != nil {
+		return nil, nil, err
+	}
+	minDeposit, err := bb.GetMinDeposit(client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	needed := new(big.Int)
+	for _, w := range []*big.Int{currentWindow, new(big.Int).Add(currentWindow, big.NewInt(1))} {
+		deposit, err := bb.GetDepositAmount(client, t.cfg.Bidder, *w)
+		if err != nil {
+			return nil, nil, err
+		}
+		if deposit.Cmp(minDeposit) < 0 {
+			needed.Add(needed, new(big.Int).Sub(minDeposit, deposit))
+		}
+	}
+
+	return new(big.Int).Sub(balance, needed), needed, nil
+}
+
+// inFlight returns the transfer that was sent but not finalized yet, if any.
+func (t *TopUp) inFlight() *TopUpRecord {
+	for _, r := range t.state.Transfers {
+		if r.FinalizedAt == 0 && r.Failed == "" {
+			return r
+		}
+	}
+	return nil
+}
+
+// bridgedSince sums the transfers sent after since that didn't fail.
+func (t *TopUp) bridgedSince(since time.Time) *big.Int {
+	total := new(big.Int)
+	for _, r := range t.state.Transfers {
+		if r.InitiatedAt >= since.Unix() && r.Failed == "" {
+			total.Add(total, r.Transfer.Amount)
+		}
+	}
+	return total
+}
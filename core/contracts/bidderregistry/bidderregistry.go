@@ -0,0 +1,1899 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bidderregistry
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BidderRegistryMetaData contains all meta data concerning the BidderRegistry contract.
+var BidderRegistryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"fallback\",\"stateMutability\":\"payable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"BidPayment\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"bidAmt\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"state\",\"type\":\"uint8\",\"internalType\":\"enumIBidderRegistry.State\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OpenBid\",\"inputs\":[{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"bidderRegistered\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"blockTrackerContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIBlockTracker\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"depositForSpecificWindow\",\"inputs\":[{\"name\":\"window\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"feePercent\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feeRecipient\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feeRecipientAmount\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDeposit\",\"inputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"window\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getFeeRecipientAmount\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProviderAmount\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_minDeposit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_feePercent\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_blockTracker\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lockedFunds\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preConfirmationsContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"protocolFeeAmount\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"providerAmount\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"retrieveFunds\",\"inputs\":[{\"name\":\"windowToSettle\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"provider\",\"type\":\"address\",\"internalType\":\"addresspayable\"},{\"name\":\"residualBidPercentAfterDecay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNewFeePercent\",\"inputs\":[{\"name\":\"newFeePercent\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNewFeeRecipient\",\"inputs\":[{\"name\":\"newFeeRecipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPreconfirmationsContract\",\"inputs\":[{\"name\":\"contractAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unlockFunds\",\"inputs\":[{\"name\":\"window\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"bidID\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawBidderAmountFromWindow\",\"inputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"addresspayable\"},{\"name\":\"window\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawFeeRecipientAmount\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawProtocolFee\",\"inputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"addresspayable\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawProviderAmount\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"internalType\":\"addresspayable\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"BidderRegistered\",\"inputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"depositedAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"windowNumber\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BidderWithdrawal\",\"inputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"window\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FundsRetrieved\",\"inputs\":[{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"window\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FundsRewarded\",\"inputs\":[{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"provider\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"window\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]}]",
+}
+
+// BidderRegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use BidderRegistryMetaData.ABI instead.
+var BidderRegistryABI = BidderRegistryMetaData.ABI
+
+// BidderRegistry is an auto generated Go binding around an Ethereum contract.
+type BidderRegistry struct {
+	BidderRegistryCaller     // Read-only binding to the contract
+	BidderRegistryTransactor // Write-only binding to the contract
+	BidderRegistryFilterer   // Log filterer for contract events
+}
+
+// BidderRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BidderRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BidderRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BidderRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BidderRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BidderRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BidderRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BidderRegistrySession struct {
+	Contract     *BidderRegistry   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BidderRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BidderRegistryCallerSession struct {
+	Contract *BidderRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// BidderRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BidderRegistryTransactorSession struct {
+	Contract     *BidderRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// BidderRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BidderRegistryRaw struct {
+	Contract *BidderRegistry // Generic contract binding to access the raw methods on
+}
+
+// BidderRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BidderRegistryCallerRaw struct {
+	Contract *BidderRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BidderRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BidderRegistryTransactorRaw struct {
+	Contract *BidderRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBidderRegistry creates a new instance of BidderRegistry, bound to a specific deployed contract.
+func NewBidderRegistry(address common.Address, backend bind.ContractBackend) (*BidderRegistry, error) {
+	contract, err := bindBidderRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistry{BidderRegistryCaller: BidderRegistryCaller{contract: contract}, BidderRegistryTransactor: BidderRegistryTransactor{contract: contract}, BidderRegistryFilterer: BidderRegistryFilterer{contract: contract}}, nil
+}
+
+// NewBidderRegistryCaller creates a new read-only instance of BidderRegistry, bound to a specific deployed contract.
+func NewBidderRegistryCaller(address common.Address, caller bind.ContractCaller) (*BidderRegistryCaller, error) {
+	contract, err := bindBidderRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryCaller{contract: contract}, nil
+}
+
+// NewBidderRegistryTransactor creates a new write-only instance of BidderRegistry, bound to a specific deployed contract.
+func NewBidderRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*BidderRegistryTransactor, error) {
+	contract, err := bindBidderRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryTransactor{contract: contract}, nil
+}
+
+// NewBidderRegistryFilterer creates a new log filterer instance of BidderRegistry, bound to a specific deployed contract.
+func NewBidderRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*BidderRegistryFilterer, error) {
+	contract, err := bindBidderRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryFilterer{contract: contract}, nil
+}
+
+// bindBidderRegistry binds a generic wrapper to an already deployed contract.
+func bindBidderRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BidderRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BidderRegistry *BidderRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BidderRegistry.Contract.BidderRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BidderRegistry *BidderRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.BidderRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BidderRegistry *BidderRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.BidderRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BidderRegistry *BidderRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BidderRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BidderRegistry *BidderRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BidderRegistry *BidderRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// BidPayment is a free data retrieval call binding the contract method 0x26a769e4.
+//
+// Solidity: function BidPayment(bytes32 ) view returns(address bidder, uint64 bidAmt, uint8 state)
+func (_BidderRegistry *BidderRegistryCaller) BidPayment(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	Bidder common.Address
+	BidAmt uint64
+	State  uint8
+}, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "BidPayment", arg0)
+
+	outstruct := new(struct {
+		Bidder common.Address
+		BidAmt uint64
+		State  uint8
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Bidder = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.BidAmt = *abi.ConvertType(out[1], new(uint64)).(*uint64)
+	outstruct.State = *abi.ConvertType(out[2], new(uint8)).(*uint8)
+
+	return *outstruct, err
+
+}
+
+// BidPayment is a free data retrieval call binding the contract method 0x26a769e4.
+//
+// Solidity: function BidPayment(bytes32 ) view returns(address bidder, uint64 bidAmt, uint8 state)
+func (_BidderRegistry *BidderRegistrySession) BidPayment(arg0 [32]byte) (struct {
+	Bidder common.Address
+	BidAmt uint64
+	State  uint8
+}, error) {
+	return _BidderRegistry.Contract.BidPayment(&_BidderRegistry.CallOpts, arg0)
+}
+
+// BidPayment is a free data retrieval call binding the contract method 0x26a769e4.
+//
+// Solidity: function BidPayment(bytes32 ) view returns(address bidder, uint64 bidAmt, uint8 state)
+func (_BidderRegistry *BidderRegistryCallerSession) BidPayment(arg0 [32]byte) (struct {
+	Bidder common.Address
+	BidAmt uint64
+	State  uint8
+}, error) {
+	return _BidderRegistry.Contract.BidPayment(&_BidderRegistry.CallOpts, arg0)
+}
+
+// BidderRegistered is a free data retrieval call binding the contract method 0x2a0773de.
+//
+// Solidity: function bidderRegistered(address ) view returns(bool)
+func (_BidderRegistry *BidderRegistryCaller) BidderRegistered(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "bidderRegistered", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// BidderRegistered is a free data retrieval call binding the contract method 0x2a0773de.
+//
+// Solidity: function bidderRegistered(address ) view returns(bool)
+func (_BidderRegistry *BidderRegistrySession) BidderRegistered(arg0 common.Address) (bool, error) {
+	return _BidderRegistry.Contract.BidderRegistered(&_BidderRegistry.CallOpts, arg0)
+}
+
+// BidderRegistered is a free data retrieval call binding the contract method 0x2a0773de.
+//
+// Solidity: function bidderRegistered(address ) view returns(bool)
+func (_BidderRegistry *BidderRegistryCallerSession) BidderRegistered(arg0 common.Address) (bool, error) {
+	return _BidderRegistry.Contract.BidderRegistered(&_BidderRegistry.CallOpts, arg0)
+}
+
+// BlockTrackerContract is a free data retrieval call binding the contract method 0x6d82071b.
+//
+// Solidity: function blockTrackerContract() view returns(address)
+func (_BidderRegistry *BidderRegistryCaller) BlockTrackerContract(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "blockTrackerContract")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BlockTrackerContract is a free data retrieval call binding the contract method 0x6d82071b.
+//
+// Solidity: function blockTrackerContract() view returns(address)
+func (_BidderRegistry *BidderRegistrySession) BlockTrackerContract() (common.Address, error) {
+	return _BidderRegistry.Contract.BlockTrackerContract(&_BidderRegistry.CallOpts)
+}
+
+// BlockTrackerContract is a free data retrieval call binding the contract method 0x6d82071b.
+//
+// Solidity: function blockTrackerContract() view returns(address)
+func (_BidderRegistry *BidderRegistryCallerSession) BlockTrackerContract() (common.Address, error) {
+	return _BidderRegistry.Contract.BlockTrackerContract(&_BidderRegistry.CallOpts)
+}
+
+// FeePercent is a free data retrieval call binding the contract method 0x7fd6f15c.
+//
+// Solidity: function feePercent() view returns(uint16)
+func (_BidderRegistry *BidderRegistryCaller) FeePercent(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "feePercent")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// FeePercent is a free data retrieval call binding the contract method 0x7fd6f15c.
+//
+// Solidity: function feePercent() view returns(uint16)
+func (_BidderRegistry *BidderRegistrySession) FeePercent() (uint16, error) {
+	return _BidderRegistry.Contract.FeePercent(&_BidderRegistry.CallOpts)
+}
+
+// FeePercent is a free data retrieval call binding the contract method 0x7fd6f15c.
+//
+// Solidity: function feePercent() view returns(uint16)
+func (_BidderRegistry *BidderRegistryCallerSession) FeePercent() (uint16, error) {
+	return _BidderRegistry.Contract.FeePercent(&_BidderRegistry.CallOpts)
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_BidderRegistry *BidderRegistryCaller) FeeRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "feeRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_BidderRegistry *BidderRegistrySession) FeeRecipient() (common.Address, error) {
+	return _BidderRegistry.Contract.FeeRecipient(&_BidderRegistry.CallOpts)
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_BidderRegistry *BidderRegistryCallerSession) FeeRecipient() (common.Address, error) {
+	return _BidderRegistry.Contract.FeeRecipient(&_BidderRegistry.CallOpts)
+}
+
+// FeeRecipientAmount is a free data retrieval call binding the contract method 0xe0ae4ebd.
+//
+// Solidity: function feeRecipientAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) FeeRecipientAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "feeRecipientAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FeeRecipientAmount is a free data retrieval call binding the contract method 0xe0ae4ebd.
+//
+// Solidity: function feeRecipientAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) FeeRecipientAmount() (*big.Int, error) {
+	return _BidderRegistry.Contract.FeeRecipientAmount(&_BidderRegistry.CallOpts)
+}
+
+// FeeRecipientAmount is a free data retrieval call binding the contract method 0xe0ae4ebd.
+//
+// Solidity: function feeRecipientAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) FeeRecipientAmount() (*big.Int, error) {
+	return _BidderRegistry.Contract.FeeRecipientAmount(&_BidderRegistry.CallOpts)
+}
+
+// GetDeposit is a free data retrieval call binding the contract method 0x2726b506.
+//
+// Solidity: function getDeposit(address bidder, uint256 window) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) GetDeposit(opts *bind.CallOpts, bidder common.Address, window *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "getDeposit", bidder, window)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetDeposit is a free data retrieval call binding the contract method 0x2726b506.
+//
+// Solidity: function getDeposit(address bidder, uint256 window) view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) GetDeposit(bidder common.Address, window *big.Int) (*big.Int, error) {
+	return _BidderRegistry.Contract.GetDeposit(&_BidderRegistry.CallOpts, bidder, window)
+}
+
+// GetDeposit is a free data retrieval call binding the contract method 0x2726b506.
+//
+// Solidity: function getDeposit(address bidder, uint256 window) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) GetDeposit(bidder common.Address, window *big.Int) (*big.Int, error) {
+	return _BidderRegistry.Contract.GetDeposit(&_BidderRegistry.CallOpts, bidder, window)
+}
+
+// GetFeeRecipientAmount is a free data retrieval call binding the contract method 0xc286f373.
+//
+// Solidity: function getFeeRecipientAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) GetFeeRecipientAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "getFeeRecipientAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetFeeRecipientAmount is a free data retrieval call binding the contract method 0xc286f373.
+//
+// Solidity: function getFeeRecipientAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) GetFeeRecipientAmount() (*big.Int, error) {
+	return _BidderRegistry.Contract.GetFeeRecipientAmount(&_BidderRegistry.CallOpts)
+}
+
+// GetFeeRecipientAmount is a free data retrieval call binding the contract method 0xc286f373.
+//
+// Solidity: function getFeeRecipientAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) GetFeeRecipientAmount() (*big.Int, error) {
+	return _BidderRegistry.Contract.GetFeeRecipientAmount(&_BidderRegistry.CallOpts)
+}
+
+// GetProviderAmount is a free data retrieval call binding the contract method 0x0ebe2555.
+//
+// Solidity: function getProviderAmount(address provider) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) GetProviderAmount(opts *bind.CallOpts, provider common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "getProviderAmount", provider)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetProviderAmount is a free data retrieval call binding the contract method 0x0ebe2555.
+//
+// Solidity: function getProviderAmount(address provider) view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) GetProviderAmount(provider common.Address) (*big.Int, error) {
+	return _BidderRegistry.Contract.GetProviderAmount(&_BidderRegistry.CallOpts, provider)
+}
+
+// GetProviderAmount is a free data retrieval call binding the contract method 0x0ebe2555.
+//
+// Solidity: function getProviderAmount(address provider) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) GetProviderAmount(provider common.Address) (*big.Int, error) {
+	return _BidderRegistry.Contract.GetProviderAmount(&_BidderRegistry.CallOpts, provider)
+}
+
+// LockedFunds is a free data retrieval call binding the contract method 0x1355d861.
+//
+// Solidity: function lockedFunds(address , uint256 ) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) LockedFunds(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "lockedFunds", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LockedFunds is a free data retrieval call binding the contract method 0x1355d861.
+//
+// Solidity: function lockedFunds(address , uint256 ) view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) LockedFunds(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _BidderRegistry.Contract.LockedFunds(&_BidderRegistry.CallOpts, arg0, arg1)
+}
+
+// LockedFunds is a free data retrieval call binding the contract method 0x1355d861.
+//
+// Solidity: function lockedFunds(address , uint256 ) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) LockedFunds(arg0 common.Address, arg1 *big.Int) (*big.Int, error) {
+	return _BidderRegistry.Contract.LockedFunds(&_BidderRegistry.CallOpts, arg0, arg1)
+}
+
+// MinDeposit is a free data retrieval call binding the contract method 0x41b3d185.
+//
+// Solidity: function minDeposit() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) MinDeposit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "minDeposit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinDeposit is a free data retrieval call binding the contract method 0x41b3d185.
+//
+// Solidity: function minDeposit() view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) MinDeposit() (*big.Int, error) {
+	return _BidderRegistry.Contract.MinDeposit(&_BidderRegistry.CallOpts)
+}
+
+// MinDeposit is a free data retrieval call binding the contract method 0x41b3d185.
+//
+// Solidity: function minDeposit() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) MinDeposit() (*big.Int, error) {
+	return _BidderRegistry.Contract.MinDeposit(&_BidderRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BidderRegistry *BidderRegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BidderRegistry *BidderRegistrySession) Owner() (common.Address, error) {
+	return _BidderRegistry.Contract.Owner(&_BidderRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BidderRegistry *BidderRegistryCallerSession) Owner() (common.Address, error) {
+	return _BidderRegistry.Contract.Owner(&_BidderRegistry.CallOpts)
+}
+
+// PreConfirmationsContract is a free data retrieval call binding the contract method 0x0de05a1e.
+//
+// Solidity: function preConfirmationsContract() view returns(address)
+func (_BidderRegistry *BidderRegistryCaller) PreConfirmationsContract(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "preConfirmationsContract")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PreConfirmationsContract is a free data retrieval call binding the contract method 0x0de05a1e.
+//
+// Solidity: function preConfirmationsContract() view returns(address)
+func (_BidderRegistry *BidderRegistrySession) PreConfirmationsContract() (common.Address, error) {
+	return _BidderRegistry.Contract.PreConfirmationsContract(&_BidderRegistry.CallOpts)
+}
+
+// PreConfirmationsContract is a free data retrieval call binding the contract method 0x0de05a1e.
+//
+// Solidity: function preConfirmationsContract() view returns(address)
+func (_BidderRegistry *BidderRegistryCallerSession) PreConfirmationsContract() (common.Address, error) {
+	return _BidderRegistry.Contract.PreConfirmationsContract(&_BidderRegistry.CallOpts)
+}
+
+// ProtocolFeeAmount is a free data retrieval call binding the contract method 0x8ec9c93b.
+//
+// Solidity: function protocolFeeAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) ProtocolFeeAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "protocolFeeAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ProtocolFeeAmount is a free data retrieval call binding the contract method 0x8ec9c93b.
+//
+// Solidity: function protocolFeeAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) ProtocolFeeAmount() (*big.Int, error) {
+	return _BidderRegistry.Contract.ProtocolFeeAmount(&_BidderRegistry.CallOpts)
+}
+
+// ProtocolFeeAmount is a free data retrieval call binding the contract method 0x8ec9c93b.
+//
+// Solidity: function protocolFeeAmount() view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) ProtocolFeeAmount() (*big.Int, error) {
+	return _BidderRegistry.Contract.ProtocolFeeAmount(&_BidderRegistry.CallOpts)
+}
+
+// ProviderAmount is a free data retrieval call binding the contract method 0x180d02cb.
+//
+// Solidity: function providerAmount(address ) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCaller) ProviderAmount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BidderRegistry.contract.Call(opts, &out, "providerAmount", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ProviderAmount is a free data retrieval call binding the contract method 0x180d02cb.
+//
+// Solidity: function providerAmount(address ) view returns(uint256)
+func (_BidderRegistry *BidderRegistrySession) ProviderAmount(arg0 common.Address) (*big.Int, error) {
+	return _BidderRegistry.Contract.ProviderAmount(&_BidderRegistry.CallOpts, arg0)
+}
+
+// ProviderAmount is a free data retrieval call binding the contract method 0x180d02cb.
+//
+// Solidity: function providerAmount(address ) view returns(uint256)
+func (_BidderRegistry *BidderRegistryCallerSession) ProviderAmount(arg0 common.Address) (*big.Int, error) {
+	return _BidderRegistry.Contract.ProviderAmount(&_BidderRegistry.CallOpts, arg0)
+}
+
+// OpenBid is a paid mutator transaction binding the contract method 0xbb0e0878.
+//
+// Solidity: function OpenBid(bytes32 commitmentDigest, uint64 bid, address bidder, uint64 blockNumber) returns()
+func (_BidderRegistry *BidderRegistryTransactor) OpenBid(opts *bind.TransactOpts, commitmentDigest [32]byte, bid uint64, bidder common.Address, blockNumber uint64) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "OpenBid", commitmentDigest, bid, bidder, blockNumber)
+}
+
+// OpenBid is a paid mutator transaction binding the contract method 0xbb0e0878.
+//
+// Solidity: function OpenBid(bytes32 commitmentDigest, uint64 bid, address bidder, uint64 blockNumber) returns()
+func (_BidderRegistry *BidderRegistrySession) OpenBid(commitmentDigest [32]byte, bid uint64, bidder common.Address, blockNumber uint64) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.OpenBid(&_BidderRegistry.TransactOpts, commitmentDigest, bid, bidder, blockNumber)
+}
+
+// OpenBid is a paid mutator transaction binding the contract method 0xbb0e0878.
+//
+// Solidity: function OpenBid(bytes32 commitmentDigest, uint64 bid, address bidder, uint64 blockNumber) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) OpenBid(commitmentDigest [32]byte, bid uint64, bidder common.Address, blockNumber uint64) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.OpenBid(&_BidderRegistry.TransactOpts, commitmentDigest, bid, bidder, blockNumber)
+}
+
+// DepositForSpecificWindow is a paid mutator transaction binding the contract method 0xe5e4bf4c.
+//
+// Solidity: function depositForSpecificWindow(uint256 window) payable returns()
+func (_BidderRegistry *BidderRegistryTransactor) DepositForSpecificWindow(opts *bind.TransactOpts, window *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "depositForSpecificWindow", window)
+}
+
+// DepositForSpecificWindow is a paid mutator transaction binding the contract method 0xe5e4bf4c.
+//
+// Solidity: function depositForSpecificWindow(uint256 window) payable returns()
+func (_BidderRegistry *BidderRegistrySession) DepositForSpecificWindow(window *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.DepositForSpecificWindow(&_BidderRegistry.TransactOpts, window)
+}
+
+// DepositForSpecificWindow is a paid mutator transaction binding the contract method 0xe5e4bf4c.
+//
+// Solidity: function depositForSpecificWindow(uint256 window) payable returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) DepositForSpecificWindow(window *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.DepositForSpecificWindow(&_BidderRegistry.TransactOpts, window)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x12c27ca2.
+//
+// Solidity: function initialize(uint256 _minDeposit, address _feeRecipient, uint16 _feePercent, address _owner, address _blockTracker) returns()
+func (_BidderRegistry *BidderRegistryTransactor) Initialize(opts *bind.TransactOpts, _minDeposit *big.Int, _feeRecipient common.Address, _feePercent uint16, _owner common.Address, _blockTracker common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "initialize", _minDeposit, _feeRecipient, _feePercent, _owner, _blockTracker)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x12c27ca2.
+//
+// Solidity: function initialize(uint256 _minDeposit, address _feeRecipient, uint16 _feePercent, address _owner, address _blockTracker) returns()
+func (_BidderRegistry *BidderRegistrySession) Initialize(_minDeposit *big.Int, _feeRecipient common.Address, _feePercent uint16, _owner common.Address, _blockTracker common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.Initialize(&_BidderRegistry.TransactOpts, _minDeposit, _feeRecipient, _feePercent, _owner, _blockTracker)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x12c27ca2.
+//
+// Solidity: function initialize(uint256 _minDeposit, address _feeRecipient, uint16 _feePercent, address _owner, address _blockTracker) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) Initialize(_minDeposit *big.Int, _feeRecipient common.Address, _feePercent uint16, _owner common.Address, _blockTracker common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.Initialize(&_BidderRegistry.TransactOpts, _minDeposit, _feeRecipient, _feePercent, _owner, _blockTracker)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BidderRegistry *BidderRegistryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BidderRegistry *BidderRegistrySession) RenounceOwnership() (*types.Transaction, error) {
+	return _BidderRegistry.Contract.RenounceOwnership(&_BidderRegistry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BidderRegistry.Contract.RenounceOwnership(&_BidderRegistry.TransactOpts)
+}
+
+// RetrieveFunds is a paid mutator transaction binding the contract method 0x0c2e5b0e.
+//
+// Solidity: function retrieveFunds(uint256 windowToSettle, bytes32 commitmentDigest, address provider, uint256 residualBidPercentAfterDecay) returns()
+func (_BidderRegistry *BidderRegistryTransactor) RetrieveFunds(opts *bind.TransactOpts, windowToSettle *big.Int, commitmentDigest [32]byte, provider common.Address, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "retrieveFunds", windowToSettle, commitmentDigest, provider, residualBidPercentAfterDecay)
+}
+
+// RetrieveFunds is a paid mutator transaction binding the contract method 0x0c2e5b0e.
+//
+// Solidity: function retrieveFunds(uint256 windowToSettle, bytes32 commitmentDigest, address provider, uint256 residualBidPercentAfterDecay) returns()
+func (_BidderRegistry *BidderRegistrySession) RetrieveFunds(windowToSettle *big.Int, commitmentDigest [32]byte, provider common.Address, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.RetrieveFunds(&_BidderRegistry.TransactOpts, windowToSettle, commitmentDigest, provider, residualBidPercentAfterDecay)
+}
+
+// RetrieveFunds is a paid mutator transaction binding the contract method 0x0c2e5b0e.
+//
+// Solidity: function retrieveFunds(uint256 windowToSettle, bytes32 commitmentDigest, address provider, uint256 residualBidPercentAfterDecay) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) RetrieveFunds(windowToSettle *big.Int, commitmentDigest [32]byte, provider common.Address, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.RetrieveFunds(&_BidderRegistry.TransactOpts, windowToSettle, commitmentDigest, provider, residualBidPercentAfterDecay)
+}
+
+// SetNewFeePercent is a paid mutator transaction binding the contract method 0xfb22febf.
+//
+// Solidity: function setNewFeePercent(uint16 newFeePercent) returns()
+func (_BidderRegistry *BidderRegistryTransactor) SetNewFeePercent(opts *bind.TransactOpts, newFeePercent uint16) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "setNewFeePercent", newFeePercent)
+}
+
+// SetNewFeePercent is a paid mutator transaction binding the contract method 0xfb22febf.
+//
+// Solidity: function setNewFeePercent(uint16 newFeePercent) returns()
+func (_BidderRegistry *BidderRegistrySession) SetNewFeePercent(newFeePercent uint16) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.SetNewFeePercent(&_BidderRegistry.TransactOpts, newFeePercent)
+}
+
+// SetNewFeePercent is a paid mutator transaction binding the contract method 0xfb22febf.
+//
+// Solidity: function setNewFeePercent(uint16 newFeePercent) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) SetNewFeePercent(newFeePercent uint16) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.SetNewFeePercent(&_BidderRegistry.TransactOpts, newFeePercent)
+}
+
+// SetNewFeeRecipient is a paid mutator transaction binding the contract method 0xa26652ea.
+//
+// Solidity: function setNewFeeRecipient(address newFeeRecipient) returns()
+func (_BidderRegistry *BidderRegistryTransactor) SetNewFeeRecipient(opts *bind.TransactOpts, newFeeRecipient common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "setNewFeeRecipient", newFeeRecipient)
+}
+
+// SetNewFeeRecipient is a paid mutator transaction binding the contract method 0xa26652ea.
+//
+// Solidity: function setNewFeeRecipient(address newFeeRecipient) returns()
+func (_BidderRegistry *BidderRegistrySession) SetNewFeeRecipient(newFeeRecipient common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.SetNewFeeRecipient(&_BidderRegistry.TransactOpts, newFeeRecipient)
+}
+
+// SetNewFeeRecipient is a paid mutator transaction binding the contract method 0xa26652ea.
+//
+// Solidity: function setNewFeeRecipient(address newFeeRecipient) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) SetNewFeeRecipient(newFeeRecipient common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.SetNewFeeRecipient(&_BidderRegistry.TransactOpts, newFeeRecipient)
+}
+
+// SetPreconfirmationsContract is a paid mutator transaction binding the contract method 0xf6c7e476.
+//
+// Solidity: function setPreconfirmationsContract(address contractAddress) returns()
+func (_BidderRegistry *BidderRegistryTransactor) SetPreconfirmationsContract(opts *bind.TransactOpts, contractAddress common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "setPreconfirmationsContract", contractAddress)
+}
+
+// SetPreconfirmationsContract is a paid mutator transaction binding the contract method 0xf6c7e476.
+//
+// Solidity: function setPreconfirmationsContract(address contractAddress) returns()
+func (_BidderRegistry *BidderRegistrySession) SetPreconfirmationsContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.SetPreconfirmationsContract(&_BidderRegistry.TransactOpts, contractAddress)
+}
+
+// SetPreconfirmationsContract is a paid mutator transaction binding the contract method 0xf6c7e476.
+//
+// Solidity: function setPreconfirmationsContract(address contractAddress) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) SetPreconfirmationsContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.SetPreconfirmationsContract(&_BidderRegistry.TransactOpts, contractAddress)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BidderRegistry *BidderRegistryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BidderRegistry *BidderRegistrySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.TransferOwnership(&_BidderRegistry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.TransferOwnership(&_BidderRegistry.TransactOpts, newOwner)
+}
+
+// UnlockFunds is a paid mutator transaction binding the contract method 0x432e707b.
+//
+// Solidity: function unlockFunds(uint256 window, bytes32 bidID) returns()
+func (_BidderRegistry *BidderRegistryTransactor) UnlockFunds(opts *bind.TransactOpts, window *big.Int, bidID [32]byte) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "unlockFunds", window, bidID)
+}
+
+// UnlockFunds is a paid mutator transaction binding the contract method 0x432e707b.
+//
+// Solidity: function unlockFunds(uint256 window, bytes32 bidID) returns()
+func (_BidderRegistry *BidderRegistrySession) UnlockFunds(window *big.Int, bidID [32]byte) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.UnlockFunds(&_BidderRegistry.TransactOpts, window, bidID)
+}
+
+// UnlockFunds is a paid mutator transaction binding the contract method 0x432e707b.
+//
+// Solidity: function unlockFunds(uint256 window, bytes32 bidID) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) UnlockFunds(window *big.Int, bidID [32]byte) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.UnlockFunds(&_BidderRegistry.TransactOpts, window, bidID)
+}
+
+// WithdrawBidderAmountFromWindow is a paid mutator transaction binding the contract method 0xa4bf023c.
+//
+// Solidity: function withdrawBidderAmountFromWindow(address bidder, uint256 window) returns()
+func (_BidderRegistry *BidderRegistryTransactor) WithdrawBidderAmountFromWindow(opts *bind.TransactOpts, bidder common.Address, window *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "withdrawBidderAmountFromWindow", bidder, window)
+}
+
+// WithdrawBidderAmountFromWindow is a paid mutator transaction binding the contract method 0xa4bf023c.
+//
+// Solidity: function withdrawBidderAmountFromWindow(address bidder, uint256 window) returns()
+func (_BidderRegistry *BidderRegistrySession) WithdrawBidderAmountFromWindow(bidder common.Address, window *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawBidderAmountFromWindow(&_BidderRegistry.TransactOpts, bidder, window)
+}
+
+// WithdrawBidderAmountFromWindow is a paid mutator transaction binding the contract method 0xa4bf023c.
+//
+// Solidity: function withdrawBidderAmountFromWindow(address bidder, uint256 window) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) WithdrawBidderAmountFromWindow(bidder common.Address, window *big.Int) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawBidderAmountFromWindow(&_BidderRegistry.TransactOpts, bidder, window)
+}
+
+// WithdrawFeeRecipientAmount is a paid mutator transaction binding the contract method 0x7e5713d8.
+//
+// Solidity: function withdrawFeeRecipientAmount() returns()
+func (_BidderRegistry *BidderRegistryTransactor) WithdrawFeeRecipientAmount(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "withdrawFeeRecipientAmount")
+}
+
+// WithdrawFeeRecipientAmount is a paid mutator transaction binding the contract method 0x7e5713d8.
+//
+// Solidity: function withdrawFeeRecipientAmount() returns()
+func (_BidderRegistry *BidderRegistrySession) WithdrawFeeRecipientAmount() (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawFeeRecipientAmount(&_BidderRegistry.TransactOpts)
+}
+
+// WithdrawFeeRecipientAmount is a paid mutator transaction binding the contract method 0x7e5713d8.
+//
+// Solidity: function withdrawFeeRecipientAmount() returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) WithdrawFeeRecipientAmount() (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawFeeRecipientAmount(&_BidderRegistry.TransactOpts)
+}
+
+// WithdrawProtocolFee is a paid mutator transaction binding the contract method 0x668fb6dc.
+//
+// Solidity: function withdrawProtocolFee(address bidder) returns()
+func (_BidderRegistry *BidderRegistryTransactor) WithdrawProtocolFee(opts *bind.TransactOpts, bidder common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "withdrawProtocolFee", bidder)
+}
+
+// WithdrawProtocolFee is a paid mutator transaction binding the contract method 0x668fb6dc.
+//
+// Solidity: function withdrawProtocolFee(address bidder) returns()
+func (_BidderRegistry *BidderRegistrySession) WithdrawProtocolFee(bidder common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawProtocolFee(&_BidderRegistry.TransactOpts, bidder)
+}
+
+// WithdrawProtocolFee is a paid mutator transaction binding the contract method 0x668fb6dc.
+//
+// Solidity: function withdrawProtocolFee(address bidder) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) WithdrawProtocolFee(bidder common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawProtocolFee(&_BidderRegistry.TransactOpts, bidder)
+}
+
+// WithdrawProviderAmount is a paid mutator transaction binding the contract method 0x9a2dd5ba.
+//
+// Solidity: function withdrawProviderAmount(address provider) returns()
+func (_BidderRegistry *BidderRegistryTransactor) WithdrawProviderAmount(opts *bind.TransactOpts, provider common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.contract.Transact(opts, "withdrawProviderAmount", provider)
+}
+
+// WithdrawProviderAmount is a paid mutator transaction binding the contract method 0x9a2dd5ba.
+//
+// Solidity: function withdrawProviderAmount(address provider) returns()
+func (_BidderRegistry *BidderRegistrySession) WithdrawProviderAmount(provider common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawProviderAmount(&_BidderRegistry.TransactOpts, provider)
+}
+
+// WithdrawProviderAmount is a paid mutator transaction binding the contract method 0x9a2dd5ba.
+//
+// Solidity: function withdrawProviderAmount(address provider) returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) WithdrawProviderAmount(provider common.Address) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.WithdrawProviderAmount(&_BidderRegistry.TransactOpts, provider)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_BidderRegistry *BidderRegistryTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _BidderRegistry.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_BidderRegistry *BidderRegistrySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.Fallback(&_BidderRegistry.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _BidderRegistry.Contract.Fallback(&_BidderRegistry.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BidderRegistry *BidderRegistryTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BidderRegistry.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BidderRegistry *BidderRegistrySession) Receive() (*types.Transaction, error) {
+	return _BidderRegistry.Contract.Receive(&_BidderRegistry.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BidderRegistry *BidderRegistryTransactorSession) Receive() (*types.Transaction, error) {
+	return _BidderRegistry.Contract.Receive(&_BidderRegistry.TransactOpts)
+}
+
+// BidderRegistryBidderRegisteredIterator is returned from FilterBidderRegistered and is used to iterate over the raw logs and unpacked data for BidderRegistered events raised by the BidderRegistry contract.
+type BidderRegistryBidderRegisteredIterator struct {
+	Event *BidderRegistryBidderRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BidderRegistryBidderRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BidderRegistryBidderRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BidderRegistryBidderRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BidderRegistryBidderRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BidderRegistryBidderRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BidderRegistryBidderRegistered represents a BidderRegistered event raised by the BidderRegistry contract.
+type BidderRegistryBidderRegistered struct {
+	Bidder          common.Address
+	DepositedAmount *big.Int
+	WindowNumber    *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidderRegistered is a free log retrieval operation binding the contract event 0x2ed10ffb7f7e5289e3bb91b8c3751388cb5d9b7f4533b9f0d59881a99822ddb3.
+//
+// Solidity: event BidderRegistered(address indexed bidder, uint256 depositedAmount, uint256 windowNumber)
+func (_BidderRegistry *BidderRegistryFilterer) FilterBidderRegistered(opts *bind.FilterOpts, bidder []common.Address) (*BidderRegistryBidderRegisteredIterator, error) {
+
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.FilterLogs(opts, "BidderRegistered", bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryBidderRegisteredIterator{contract: _BidderRegistry.contract, event: "BidderRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchBidderRegistered is a free log subscription operation binding the contract event 0x2ed10ffb7f7e5289e3bb91b8c3751388cb5d9b7f4533b9f0d59881a99822ddb3.
+//
+// Solidity: event BidderRegistered(address indexed bidder, uint256 depositedAmount, uint256 windowNumber)
+func (_BidderRegistry *BidderRegistryFilterer) WatchBidderRegistered(opts *bind.WatchOpts, sink chan<- *BidderRegistryBidderRegistered, bidder []common.Address) (event.Subscription, error) {
+
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.WatchLogs(opts, "BidderRegistered", bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BidderRegistryBidderRegistered)
+				if err := _BidderRegistry.contract.UnpackLog(event, "BidderRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidderRegistered is a log parse operation binding the contract event 0x2ed10ffb7f7e5289e3bb91b8c3751388cb5d9b7f4533b9f0d59881a99822ddb3.
+//
+// Solidity: event BidderRegistered(address indexed bidder, uint256 depositedAmount, uint256 windowNumber)
+func (_BidderRegistry *BidderRegistryFilterer) ParseBidderRegistered(log types.Log) (*BidderRegistryBidderRegistered, error) {
+	event := new(BidderRegistryBidderRegistered)
+	if err := _BidderRegistry.contract.UnpackLog(event, "BidderRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BidderRegistryBidderWithdrawalIterator is returned from FilterBidderWithdrawal and is used to iterate over the raw logs and unpacked data for BidderWithdrawal events raised by the BidderRegistry contract.
+type BidderRegistryBidderWithdrawalIterator struct {
+	Event *BidderRegistryBidderWithdrawal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BidderRegistryBidderWithdrawalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BidderRegistryBidderWithdrawal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BidderRegistryBidderWithdrawal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BidderRegistryBidderWithdrawalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BidderRegistryBidderWithdrawalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BidderRegistryBidderWithdrawal represents a BidderWithdrawal event raised by the BidderRegistry contract.
+type BidderRegistryBidderWithdrawal struct {
+	Bidder common.Address
+	Window *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidderWithdrawal is a free log retrieval operation binding the contract event 0x2be239cccec761cb15b4070dda36677f39cb05afba45c7419fe7e27ed2c90b29.
+//
+// Solidity: event BidderWithdrawal(address indexed bidder, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) FilterBidderWithdrawal(opts *bind.FilterOpts, bidder []common.Address) (*BidderRegistryBidderWithdrawalIterator, error) {
+
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.FilterLogs(opts, "BidderWithdrawal", bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryBidderWithdrawalIterator{contract: _BidderRegistry.contract, event: "BidderWithdrawal", logs: logs, sub: sub}, nil
+}
+
+// WatchBidderWithdrawal is a free log subscription operation binding the contract event 0x2be239cccec761cb15b4070dda36677f39cb05afba45c7419fe7e27ed2c90b29.
+//
+// Solidity: event BidderWithdrawal(address indexed bidder, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) WatchBidderWithdrawal(opts *bind.WatchOpts, sink chan<- *BidderRegistryBidderWithdrawal, bidder []common.Address) (event.Subscription, error) {
+
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.WatchLogs(opts, "BidderWithdrawal", bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BidderRegistryBidderWithdrawal)
+				if err := _BidderRegistry.contract.UnpackLog(event, "BidderWithdrawal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidderWithdrawal is a log parse operation binding the contract event 0x2be239cccec761cb15b4070dda36677f39cb05afba45c7419fe7e27ed2c90b29.
+//
+// Solidity: event BidderWithdrawal(address indexed bidder, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) ParseBidderWithdrawal(log types.Log) (*BidderRegistryBidderWithdrawal, error) {
+	event := new(BidderRegistryBidderWithdrawal)
+	if err := _BidderRegistry.contract.UnpackLog(event, "BidderWithdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BidderRegistryFundsRetrievedIterator is returned from FilterFundsRetrieved and is used to iterate over the raw logs and unpacked data for FundsRetrieved events raised by the BidderRegistry contract.
+type BidderRegistryFundsRetrievedIterator struct {
+	Event *BidderRegistryFundsRetrieved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BidderRegistryFundsRetrievedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BidderRegistryFundsRetrieved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BidderRegistryFundsRetrieved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BidderRegistryFundsRetrievedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BidderRegistryFundsRetrievedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BidderRegistryFundsRetrieved represents a FundsRetrieved event raised by the BidderRegistry contract.
+type BidderRegistryFundsRetrieved struct {
+	CommitmentDigest [32]byte
+	Bidder           common.Address
+	Window           *big.Int
+	Amount           *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterFundsRetrieved is a free log retrieval operation binding the contract event 0x4ee0e06b2d2e4d1f06e75df9f2bad2c919d860fbf843f3b1f12de3264471a102.
+//
+// Solidity: event FundsRetrieved(bytes32 indexed commitmentDigest, address indexed bidder, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) FilterFundsRetrieved(opts *bind.FilterOpts, commitmentDigest [][32]byte, bidder []common.Address) (*BidderRegistryFundsRetrievedIterator, error) {
+
+	var commitmentDigestRule []interface{}
+	for _, commitmentDigestItem := range commitmentDigest {
+		commitmentDigestRule = append(commitmentDigestRule, commitmentDigestItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.FilterLogs(opts, "FundsRetrieved", commitmentDigestRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryFundsRetrievedIterator{contract: _BidderRegistry.contract, event: "FundsRetrieved", logs: logs, sub: sub}, nil
+}
+
+// WatchFundsRetrieved is a free log subscription operation binding the contract event 0x4ee0e06b2d2e4d1f06e75df9f2bad2c919d860fbf843f3b1f12de3264471a102.
+//
+// Solidity: event FundsRetrieved(bytes32 indexed commitmentDigest, address indexed bidder, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) WatchFundsRetrieved(opts *bind.WatchOpts, sink chan<- *BidderRegistryFundsRetrieved, commitmentDigest [][32]byte, bidder []common.Address) (event.Subscription, error) {
+
+	var commitmentDigestRule []interface{}
+	for _, commitmentDigestItem := range commitmentDigest {
+		commitmentDigestRule = append(commitmentDigestRule, commitmentDigestItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.WatchLogs(opts, "FundsRetrieved", commitmentDigestRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BidderRegistryFundsRetrieved)
+				if err := _BidderRegistry.contract.UnpackLog(event, "FundsRetrieved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFundsRetrieved is a log parse operation binding the contract event 0x4ee0e06b2d2e4d1f06e75df9f2bad2c919d860fbf843f3b1f12de3264471a102.
+//
+// Solidity: event FundsRetrieved(bytes32 indexed commitmentDigest, address indexed bidder, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) ParseFundsRetrieved(log types.Log) (*BidderRegistryFundsRetrieved, error) {
+	event := new(BidderRegistryFundsRetrieved)
+	if err := _BidderRegistry.contract.UnpackLog(event, "FundsRetrieved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BidderRegistryFundsRewardedIterator is returned from FilterFundsRewarded and is used to iterate over the raw logs and unpacked data for FundsRewarded events raised by the BidderRegistry contract.
+type BidderRegistryFundsRewardedIterator struct {
+	Event *BidderRegistryFundsRewarded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BidderRegistryFundsRewardedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BidderRegistryFundsRewarded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BidderRegistryFundsRewarded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BidderRegistryFundsRewardedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BidderRegistryFundsRewardedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BidderRegistryFundsRewarded represents a FundsRewarded event raised by the BidderRegistry contract.
+type BidderRegistryFundsRewarded struct {
+	CommitmentDigest [32]byte
+	Bidder           common.Address
+	Provider         common.Address
+	Window           *big.Int
+	Amount           *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterFundsRewarded is a free log retrieval operation binding the contract event 0xd26f9e20ff994b4298fe22216ee15de6c9b7a46164d7a5509f2c4d065d8b408a.
+//
+// Solidity: event FundsRewarded(bytes32 indexed commitmentDigest, address indexed bidder, address indexed provider, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) FilterFundsRewarded(opts *bind.FilterOpts, commitmentDigest [][32]byte, bidder []common.Address, provider []common.Address) (*BidderRegistryFundsRewardedIterator, error) {
+
+	var commitmentDigestRule []interface{}
+	for _, commitmentDigestItem := range commitmentDigest {
+		commitmentDigestRule = append(commitmentDigestRule, commitmentDigestItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.FilterLogs(opts, "FundsRewarded", commitmentDigestRule, bidderRule, providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryFundsRewardedIterator{contract: _BidderRegistry.contract, event: "FundsRewarded", logs: logs, sub: sub}, nil
+}
+
+// WatchFundsRewarded is a free log subscription operation binding the contract event 0xd26f9e20ff994b4298fe22216ee15de6c9b7a46164d7a5509f2c4d065d8b408a.
+//
+// Solidity: event FundsRewarded(bytes32 indexed commitmentDigest, address indexed bidder, address indexed provider, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) WatchFundsRewarded(opts *bind.WatchOpts, sink chan<- *BidderRegistryFundsRewarded, commitmentDigest [][32]byte, bidder []common.Address, provider []common.Address) (event.Subscription, error) {
+
+	var commitmentDigestRule []interface{}
+	for _, commitmentDigestItem := range commitmentDigest {
+		commitmentDigestRule = append(commitmentDigestRule, commitmentDigestItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.WatchLogs(opts, "FundsRewarded", commitmentDigestRule, bidderRule, providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BidderRegistryFundsRewarded)
+				if err := _BidderRegistry.contract.UnpackLog(event, "FundsRewarded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFundsRewarded is a log parse operation binding the contract event 0xd26f9e20ff994b4298fe22216ee15de6c9b7a46164d7a5509f2c4d065d8b408a.
+//
+// Solidity: event FundsRewarded(bytes32 indexed commitmentDigest, address indexed bidder, address indexed provider, uint256 window, uint256 amount)
+func (_BidderRegistry *BidderRegistryFilterer) ParseFundsRewarded(log types.Log) (*BidderRegistryFundsRewarded, error) {
+	event := new(BidderRegistryFundsRewarded)
+	if err := _BidderRegistry.contract.UnpackLog(event, "FundsRewarded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BidderRegistryInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the BidderRegistry contract.
+type BidderRegistryInitializedIterator struct {
+	Event *BidderRegistryInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BidderRegistryInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BidderRegistryInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BidderRegistryInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BidderRegistryInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BidderRegistryInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BidderRegistryInitialized represents a Initialized event raised by the BidderRegistry contract.
+type BidderRegistryInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BidderRegistry *BidderRegistryFilterer) FilterInitialized(opts *bind.FilterOpts) (*BidderRegistryInitializedIterator, error) {
+
+	logs, sub, err := _BidderRegistry.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryInitializedIterator{contract: _BidderRegistry.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BidderRegistry *BidderRegistryFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *BidderRegistryInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _BidderRegistry.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BidderRegistryInitialized)
+				if err := _BidderRegistry.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BidderRegistry *BidderRegistryFilterer) ParseInitialized(log types.Log) (*BidderRegistryInitialized, error) {
+	event := new(BidderRegistryInitialized)
+	if err := _BidderRegistry.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BidderRegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the BidderRegistry contract.
+type BidderRegistryOwnershipTransferredIterator struct {
+	Event *BidderRegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BidderRegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BidderRegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BidderRegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BidderRegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BidderRegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BidderRegistryOwnershipTransferred represents a OwnershipTransferred event raised by the BidderRegistry contract.
+type BidderRegistryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BidderRegistry *BidderRegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BidderRegistryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BidderRegistryOwnershipTransferredIterator{contract: _BidderRegistry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BidderRegistry *BidderRegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BidderRegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BidderRegistry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BidderRegistryOwnershipTransferred)
+				if err := _BidderRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BidderRegistry *BidderRegistryFilterer) ParseOwnershipTransferred(log types.Log) (*BidderRegistryOwnershipTransferred, error) {
+	event := new(BidderRegistryOwnershipTransferred)
+	if err := _BidderRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
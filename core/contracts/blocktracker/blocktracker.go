@@ -0,0 +1,1365 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package blocktracker
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BlockTrackerMetaData contains all meta data concerning the BlockTracker contract.
+var BlockTrackerMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"fallback\",\"stateMutability\":\"payable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"addBuilderAddress\",\"inputs\":[{\"name\":\"builderName\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"builderAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"blockBuilderNameToAddress\",\"inputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"blockWinners\",\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"blocksPerWindow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentWindow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBlockWinner\",\"inputs\":[{\"name\":\"blockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBlocksPerWindow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBuilder\",\"inputs\":[{\"name\":\"builderNameGrafiti\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCurrentWindow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWindowFromBlockNumber\",\"inputs\":[{\"name\":\"blockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"recordL1Block\",\"inputs\":[{\"name\":\"_blockNumber\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_winnerGraffiti\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"NewBlocksPerWindow\",\"inputs\":[{\"name\":\"blocksPerWindow\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"NewL1Block\",\"inputs\":[{\"name\":\"blockNumber\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"winner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"window\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"NewWindow\",\"inputs\":[{\"name\":\"window\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+}
+
+// BlockTrackerABI is the input ABI used to generate the binding from.
+// Deprecated: Use BlockTrackerMetaData.ABI instead.
+var BlockTrackerABI = BlockTrackerMetaData.ABI
+
+// BlockTracker is an auto generated Go binding around an Ethereum contract.
+type BlockTracker struct {
+	BlockTrackerCaller     // Read-only binding to the contract
+	BlockTrackerTransactor // Write-only binding to the contract
+	BlockTrackerFilterer   // Log filterer for contract events
+}
+
+// BlockTrackerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BlockTrackerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockTrackerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BlockTrackerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockTrackerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BlockTrackerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BlockTrackerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BlockTrackerSession struct {
+	Contract     *BlockTracker     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BlockTrackerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BlockTrackerCallerSession struct {
+	Contract *BlockTrackerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// BlockTrackerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BlockTrackerTransactorSession struct {
+	Contract     *BlockTrackerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// BlockTrackerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BlockTrackerRaw struct {
+	Contract *BlockTracker // Generic contract binding to access the raw methods on
+}
+
+// BlockTrackerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BlockTrackerCallerRaw struct {
+	Contract *BlockTrackerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BlockTrackerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BlockTrackerTransactorRaw struct {
+	Contract *BlockTrackerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBlockTracker creates a new instance of BlockTracker, bound to a specific deployed contract.
+func NewBlockTracker(address common.Address, backend bind.ContractBackend) (*BlockTracker, error) {
+	contract, err := bindBlockTracker(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTracker{BlockTrackerCaller: BlockTrackerCaller{contract: contract}, BlockTrackerTransactor: BlockTrackerTransactor{contract: contract}, BlockTrackerFilterer: BlockTrackerFilterer{contract: contract}}, nil
+}
+
+// NewBlockTrackerCaller creates a new read-only instance of BlockTracker, bound to a specific deployed contract.
+func NewBlockTrackerCaller(address common.Address, caller bind.ContractCaller) (*BlockTrackerCaller, error) {
+	contract, err := bindBlockTracker(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerCaller{contract: contract}, nil
+}
+
+// NewBlockTrackerTransactor creates a new write-only instance of BlockTracker, bound to a specific deployed contract.
+func NewBlockTrackerTransactor(address common.Address, transactor bind.ContractTransactor) (*BlockTrackerTransactor, error) {
+	contract, err := bindBlockTracker(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerTransactor{contract: contract}, nil
+}
+
+// NewBlockTrackerFilterer creates a new log filterer instance of BlockTracker, bound to a specific deployed contract.
+func NewBlockTrackerFilterer(address common.Address, filterer bind.ContractFilterer) (*BlockTrackerFilterer, error) {
+	contract, err := bindBlockTracker(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerFilterer{contract: contract}, nil
+}
+
+// bindBlockTracker binds a generic wrapper to an already deployed contract.
+func bindBlockTracker(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BlockTrackerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BlockTracker *BlockTrackerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BlockTracker.Contract.BlockTrackerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BlockTracker *BlockTrackerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BlockTracker.Contract.BlockTrackerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BlockTracker *BlockTrackerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BlockTracker.Contract.BlockTrackerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BlockTracker *BlockTrackerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BlockTracker.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BlockTracker *BlockTrackerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BlockTracker.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BlockTracker *BlockTrackerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BlockTracker.Contract.contract.Transact(opts, method, params...)
+}
+
+// BlockBuilderNameToAddress is a free data retrieval call binding the contract method 0xeebac3ac.
+//
+// Solidity: function blockBuilderNameToAddress(string ) view returns(address)
+func (_BlockTracker *BlockTrackerCaller) BlockBuilderNameToAddress(opts *bind.CallOpts, arg0 string) (common.Address, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "blockBuilderNameToAddress", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BlockBuilderNameToAddress is a free data retrieval call binding the contract method 0xeebac3ac.
+//
+// Solidity: function blockBuilderNameToAddress(string ) view returns(address)
+func (_BlockTracker *BlockTrackerSession) BlockBuilderNameToAddress(arg0 string) (common.Address, error) {
+	return _BlockTracker.Contract.BlockBuilderNameToAddress(&_BlockTracker.CallOpts, arg0)
+}
+
+// BlockBuilderNameToAddress is a free data retrieval call binding the contract method 0xeebac3ac.
+//
+// Solidity: function blockBuilderNameToAddress(string ) view returns(address)
+func (_BlockTracker *BlockTrackerCallerSession) BlockBuilderNameToAddress(arg0 string) (common.Address, error) {
+	return _BlockTracker.Contract.BlockBuilderNameToAddress(&_BlockTracker.CallOpts, arg0)
+}
+
+// BlockWinners is a free data retrieval call binding the contract method 0xe4747419.
+//
+// Solidity: function blockWinners(uint256 ) view returns(address)
+func (_BlockTracker *BlockTrackerCaller) BlockWinners(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "blockWinners", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BlockWinners is a free data retrieval call binding the contract method 0xe4747419.
+//
+// Solidity: function blockWinners(uint256 ) view returns(address)
+func (_BlockTracker *BlockTrackerSession) BlockWinners(arg0 *big.Int) (common.Address, error) {
+	return _BlockTracker.Contract.BlockWinners(&_BlockTracker.CallOpts, arg0)
+}
+
+// BlockWinners is a free data retrieval call binding the contract method 0xe4747419.
+//
+// Solidity: function blockWinners(uint256 ) view returns(address)
+func (_BlockTracker *BlockTrackerCallerSession) BlockWinners(arg0 *big.Int) (common.Address, error) {
+	return _BlockTracker.Contract.BlockWinners(&_BlockTracker.CallOpts, arg0)
+}
+
+// BlocksPerWindow is a free data retrieval call binding the contract method 0x6347609e.
+//
+// Solidity: function blocksPerWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCaller) BlocksPerWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "blocksPerWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BlocksPerWindow is a free data retrieval call binding the contract method 0x6347609e.
+//
+// Solidity: function blocksPerWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerSession) BlocksPerWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.BlocksPerWindow(&_BlockTracker.CallOpts)
+}
+
+// BlocksPerWindow is a free data retrieval call binding the contract method 0x6347609e.
+//
+// Solidity: function blocksPerWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCallerSession) BlocksPerWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.BlocksPerWindow(&_BlockTracker.CallOpts)
+}
+
+// CurrentWindow is a free data retrieval call binding the contract method 0xba0bafb4.
+//
+// Solidity: function currentWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCaller) CurrentWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "currentWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentWindow is a free data retrieval call binding the contract method 0xba0bafb4.
+//
+// Solidity: function currentWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerSession) CurrentWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.CurrentWindow(&_BlockTracker.CallOpts)
+}
+
+// CurrentWindow is a free data retrieval call binding the contract method 0xba0bafb4.
+//
+// Solidity: function currentWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCallerSession) CurrentWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.CurrentWindow(&_BlockTracker.CallOpts)
+}
+
+// GetBlockWinner is a free data retrieval call binding the contract method 0x6753ab34.
+//
+// Solidity: function getBlockWinner(uint256 blockNumber) view returns(address)
+func (_BlockTracker *BlockTrackerCaller) GetBlockWinner(opts *bind.CallOpts, blockNumber *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "getBlockWinner", blockNumber)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetBlockWinner is a free data retrieval call binding the contract method 0x6753ab34.
+//
+// Solidity: function getBlockWinner(uint256 blockNumber) view returns(address)
+func (_BlockTracker *BlockTrackerSession) GetBlockWinner(blockNumber *big.Int) (common.Address, error) {
+	return _BlockTracker.Contract.GetBlockWinner(&_BlockTracker.CallOpts, blockNumber)
+}
+
+// GetBlockWinner is a free data retrieval call binding the contract method 0x6753ab34.
+//
+// Solidity: function getBlockWinner(uint256 blockNumber) view returns(address)
+func (_BlockTracker *BlockTrackerCallerSession) GetBlockWinner(blockNumber *big.Int) (common.Address, error) {
+	return _BlockTracker.Contract.GetBlockWinner(&_BlockTracker.CallOpts, blockNumber)
+}
+
+// GetBlocksPerWindow is a free data retrieval call binding the contract method 0x8711a019.
+//
+// Solidity: function getBlocksPerWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCaller) GetBlocksPerWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "getBlocksPerWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlocksPerWindow is a free data retrieval call binding the contract method 0x8711a019.
+//
+// Solidity: function getBlocksPerWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerSession) GetBlocksPerWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.GetBlocksPerWindow(&_BlockTracker.CallOpts)
+}
+
+// GetBlocksPerWindow is a free data retrieval call binding the contract method 0x8711a019.
+//
+// Solidity: function getBlocksPerWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCallerSession) GetBlocksPerWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.GetBlocksPerWindow(&_BlockTracker.CallOpts)
+}
+
+// GetBuilder is a free data retrieval call binding the contract method 0x237ba8fb.
+//
+// Solidity: function getBuilder(string builderNameGrafiti) view returns(address)
+func (_BlockTracker *BlockTrackerCaller) GetBuilder(opts *bind.CallOpts, builderNameGrafiti string) (common.Address, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "getBuilder", builderNameGrafiti)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetBuilder is a free data retrieval call binding the contract method 0x237ba8fb.
+//
+// Solidity: function getBuilder(string builderNameGrafiti) view returns(address)
+func (_BlockTracker *BlockTrackerSession) GetBuilder(builderNameGrafiti string) (common.Address, error) {
+	return _BlockTracker.Contract.GetBuilder(&_BlockTracker.CallOpts, builderNameGrafiti)
+}
+
+// GetBuilder is a free data retrieval call binding the contract method 0x237ba8fb.
+//
+// Solidity: function getBuilder(string builderNameGrafiti) view returns(address)
+func (_BlockTracker *BlockTrackerCallerSession) GetBuilder(builderNameGrafiti string) (common.Address, error) {
+	return _BlockTracker.Contract.GetBuilder(&_BlockTracker.CallOpts, builderNameGrafiti)
+}
+
+// GetCurrentWindow is a free data retrieval call binding the contract method 0x0f67e7d5.
+//
+// Solidity: function getCurrentWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCaller) GetCurrentWindow(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "getCurrentWindow")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCurrentWindow is a free data retrieval call binding the contract method 0x0f67e7d5.
+//
+// Solidity: function getCurrentWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerSession) GetCurrentWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.GetCurrentWindow(&_BlockTracker.CallOpts)
+}
+
+// GetCurrentWindow is a free data retrieval call binding the contract method 0x0f67e7d5.
+//
+// Solidity: function getCurrentWindow() view returns(uint256)
+func (_BlockTracker *BlockTrackerCallerSession) GetCurrentWindow() (*big.Int, error) {
+	return _BlockTracker.Contract.GetCurrentWindow(&_BlockTracker.CallOpts)
+}
+
+// GetWindowFromBlockNumber is a free data retrieval call binding the contract method 0x70ef60db.
+//
+// Solidity: function getWindowFromBlockNumber(uint256 blockNumber) view returns(uint256)
+func (_BlockTracker *BlockTrackerCaller) GetWindowFromBlockNumber(opts *bind.CallOpts, blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "getWindowFromBlockNumber", blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWindowFromBlockNumber is a free data retrieval call binding the contract method 0x70ef60db.
+//
+// Solidity: function getWindowFromBlockNumber(uint256 blockNumber) view returns(uint256)
+func (_BlockTracker *BlockTrackerSession) GetWindowFromBlockNumber(blockNumber *big.Int) (*big.Int, error) {
+	return _BlockTracker.Contract.GetWindowFromBlockNumber(&_BlockTracker.CallOpts, blockNumber)
+}
+
+// GetWindowFromBlockNumber is a free data retrieval call binding the contract method 0x70ef60db.
+//
+// Solidity: function getWindowFromBlockNumber(uint256 blockNumber) view returns(uint256)
+func (_BlockTracker *BlockTrackerCallerSession) GetWindowFromBlockNumber(blockNumber *big.Int) (*big.Int, error) {
+	return _BlockTracker.Contract.GetWindowFromBlockNumber(&_BlockTracker.CallOpts, blockNumber)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BlockTracker *BlockTrackerCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BlockTracker.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BlockTracker *BlockTrackerSession) Owner() (common.Address, error) {
+	return _BlockTracker.Contract.Owner(&_BlockTracker.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_BlockTracker *BlockTrackerCallerSession) Owner() (common.Address, error) {
+	return _BlockTracker.Contract.Owner(&_BlockTracker.CallOpts)
+}
+
+// AddBuilderAddress is a paid mutator transaction binding the contract method 0x0bd0a9e1.
+//
+// Solidity: function addBuilderAddress(string builderName, address builderAddress) returns()
+func (_BlockTracker *BlockTrackerTransactor) AddBuilderAddress(opts *bind.TransactOpts, builderName string, builderAddress common.Address) (*types.Transaction, error) {
+	return _BlockTracker.contract.Transact(opts, "addBuilderAddress", builderName, builderAddress)
+}
+
+// AddBuilderAddress is a paid mutator transaction binding the contract method 0x0bd0a9e1.
+//
+// Solidity: function addBuilderAddress(string builderName, address builderAddress) returns()
+func (_BlockTracker *BlockTrackerSession) AddBuilderAddress(builderName string, builderAddress common.Address) (*types.Transaction, error) {
+	return _BlockTracker.Contract.AddBuilderAddress(&_BlockTracker.TransactOpts, builderName, builderAddress)
+}
+
+// AddBuilderAddress is a paid mutator transaction binding the contract method 0x0bd0a9e1.
+//
+// Solidity: function addBuilderAddress(string builderName, address builderAddress) returns()
+func (_BlockTracker *BlockTrackerTransactorSession) AddBuilderAddress(builderName string, builderAddress common.Address) (*types.Transaction, error) {
+	return _BlockTracker.Contract.AddBuilderAddress(&_BlockTracker.TransactOpts, builderName, builderAddress)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address _owner) returns()
+func (_BlockTracker *BlockTrackerTransactor) Initialize(opts *bind.TransactOpts, _owner common.Address) (*types.Transaction, error) {
+	return _BlockTracker.contract.Transact(opts, "initialize", _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address _owner) returns()
+func (_BlockTracker *BlockTrackerSession) Initialize(_owner common.Address) (*types.Transaction, error) {
+	return _BlockTracker.Contract.Initialize(&_BlockTracker.TransactOpts, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address _owner) returns()
+func (_BlockTracker *BlockTrackerTransactorSession) Initialize(_owner common.Address) (*types.Transaction, error) {
+	return _BlockTracker.Contract.Initialize(&_BlockTracker.TransactOpts, _owner)
+}
+
+// RecordL1Block is a paid mutator transaction binding the contract method 0x1d63f108.
+//
+// Solidity: function recordL1Block(uint256 _blockNumber, string _winnerGraffiti) returns()
+func (_BlockTracker *BlockTrackerTransactor) RecordL1Block(opts *bind.TransactOpts, _blockNumber *big.Int, _winnerGraffiti string) (*types.Transaction, error) {
+	return _BlockTracker.contract.Transact(opts, "recordL1Block", _blockNumber, _winnerGraffiti)
+}
+
+// RecordL1Block is a paid mutator transaction binding the contract method 0x1d63f108.
+//
+// Solidity: function recordL1Block(uint256 _blockNumber, string _winnerGraffiti) returns()
+func (_BlockTracker *BlockTrackerSession) RecordL1Block(_blockNumber *big.Int, _winnerGraffiti string) (*types.Transaction, error) {
+	return _BlockTracker.Contract.RecordL1Block(&_BlockTracker.TransactOpts, _blockNumber, _winnerGraffiti)
+}
+
+// RecordL1Block is a paid mutator transaction binding the contract method 0x1d63f108.
+//
+// Solidity: function recordL1Block(uint256 _blockNumber, string _winnerGraffiti) returns()
+func (_BlockTracker *BlockTrackerTransactorSession) RecordL1Block(_blockNumber *big.Int, _winnerGraffiti string) (*types.Transaction, error) {
+	return _BlockTracker.Contract.RecordL1Block(&_BlockTracker.TransactOpts, _blockNumber, _winnerGraffiti)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BlockTracker *BlockTrackerTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BlockTracker.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BlockTracker *BlockTrackerSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BlockTracker.Contract.RenounceOwnership(&_BlockTracker.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_BlockTracker *BlockTrackerTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _BlockTracker.Contract.RenounceOwnership(&_BlockTracker.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BlockTracker *BlockTrackerTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _BlockTracker.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BlockTracker *BlockTrackerSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BlockTracker.Contract.TransferOwnership(&_BlockTracker.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_BlockTracker *BlockTrackerTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _BlockTracker.Contract.TransferOwnership(&_BlockTracker.TransactOpts, newOwner)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_BlockTracker *BlockTrackerTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _BlockTracker.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_BlockTracker *BlockTrackerSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _BlockTracker.Contract.Fallback(&_BlockTracker.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_BlockTracker *BlockTrackerTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _BlockTracker.Contract.Fallback(&_BlockTracker.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BlockTracker *BlockTrackerTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BlockTracker.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BlockTracker *BlockTrackerSession) Receive() (*types.Transaction, error) {
+	return _BlockTracker.Contract.Receive(&_BlockTracker.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_BlockTracker *BlockTrackerTransactorSession) Receive() (*types.Transaction, error) {
+	return _BlockTracker.Contract.Receive(&_BlockTracker.TransactOpts)
+}
+
+// BlockTrackerInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the BlockTracker contract.
+type BlockTrackerInitializedIterator struct {
+	Event *BlockTrackerInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockTrackerInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockTrackerInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockTrackerInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockTrackerInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockTrackerInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockTrackerInitialized represents a Initialized event raised by the BlockTracker contract.
+type BlockTrackerInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BlockTracker *BlockTrackerFilterer) FilterInitialized(opts *bind.FilterOpts) (*BlockTrackerInitializedIterator, error) {
+
+	logs, sub, err := _BlockTracker.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerInitializedIterator{contract: _BlockTracker.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BlockTracker *BlockTrackerFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *BlockTrackerInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _BlockTracker.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockTrackerInitialized)
+				if err := _BlockTracker.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_BlockTracker *BlockTrackerFilterer) ParseInitialized(log types.Log) (*BlockTrackerInitialized, error) {
+	event := new(BlockTrackerInitialized)
+	if err := _BlockTracker.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BlockTrackerNewBlocksPerWindowIterator is returned from FilterNewBlocksPerWindow and is used to iterate over the raw logs and unpacked data for NewBlocksPerWindow events raised by the BlockTracker contract.
+type BlockTrackerNewBlocksPerWindowIterator struct {
+	Event *BlockTrackerNewBlocksPerWindow // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockTrackerNewBlocksPerWindowIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockTrackerNewBlocksPerWindow)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockTrackerNewBlocksPerWindow)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockTrackerNewBlocksPerWindowIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockTrackerNewBlocksPerWindowIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockTrackerNewBlocksPerWindow represents a NewBlocksPerWindow event raised by the BlockTracker contract.
+type BlockTrackerNewBlocksPerWindow struct {
+	BlocksPerWindow *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewBlocksPerWindow is a free log retrieval operation binding the contract event 0xd2abfe7e41e182f2121a97e57d5133f2ccef005fb15e25ef2f09d4a6657e20e0.
+//
+// Solidity: event NewBlocksPerWindow(uint256 blocksPerWindow)
+func (_BlockTracker *BlockTrackerFilterer) FilterNewBlocksPerWindow(opts *bind.FilterOpts) (*BlockTrackerNewBlocksPerWindowIterator, error) {
+
+	logs, sub, err := _BlockTracker.contract.FilterLogs(opts, "NewBlocksPerWindow")
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerNewBlocksPerWindowIterator{contract: _BlockTracker.contract, event: "NewBlocksPerWindow", logs: logs, sub: sub}, nil
+}
+
+// WatchNewBlocksPerWindow is a free log subscription operation binding the contract event 0xd2abfe7e41e182f2121a97e57d5133f2ccef005fb15e25ef2f09d4a6657e20e0.
+//
+// Solidity: event NewBlocksPerWindow(uint256 blocksPerWindow)
+func (_BlockTracker *BlockTrackerFilterer) WatchNewBlocksPerWindow(opts *bind.WatchOpts, sink chan<- *BlockTrackerNewBlocksPerWindow) (event.Subscription, error) {
+
+	logs, sub, err := _BlockTracker.contract.WatchLogs(opts, "NewBlocksPerWindow")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockTrackerNewBlocksPerWindow)
+				if err := _BlockTracker.contract.UnpackLog(event, "NewBlocksPerWindow", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewBlocksPerWindow is a log parse operation binding the contract event 0xd2abfe7e41e182f2121a97e57d5133f2ccef005fb15e25ef2f09d4a6657e20e0.
+//
+// Solidity: event NewBlocksPerWindow(uint256 blocksPerWindow)
+func (_BlockTracker *BlockTrackerFilterer) ParseNewBlocksPerWindow(log types.Log) (*BlockTrackerNewBlocksPerWindow, error) {
+	event := new(BlockTrackerNewBlocksPerWindow)
+	if err := _BlockTracker.contract.UnpackLog(event, "NewBlocksPerWindow", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BlockTrackerNewL1BlockIterator is returned from FilterNewL1Block and is used to iterate over the raw logs and unpacked data for NewL1Block events raised by the BlockTracker contract.
+type BlockTrackerNewL1BlockIterator struct {
+	Event *BlockTrackerNewL1Block // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockTrackerNewL1BlockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockTrackerNewL1Block)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockTrackerNewL1Block)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockTrackerNewL1BlockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockTrackerNewL1BlockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockTrackerNewL1Block represents a NewL1Block event raised by the BlockTracker contract.
+type BlockTrackerNewL1Block struct {
+	BlockNumber *big.Int
+	Winner      common.Address
+	Window      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewL1Block is a free log retrieval operation binding the contract event 0x8323d3e5d25db513e1a772870aaa45e9b069a13d49879d72e70638b5c1c18cb7.
+//
+// Solidity: event NewL1Block(uint256 indexed blockNumber, address indexed winner, uint256 indexed window)
+func (_BlockTracker *BlockTrackerFilterer) FilterNewL1Block(opts *bind.FilterOpts, blockNumber []*big.Int, winner []common.Address, window []*big.Int) (*BlockTrackerNewL1BlockIterator, error) {
+
+	var blockNumberRule []interface{}
+	for _, blockNumberItem := range blockNumber {
+		blockNumberRule = append(blockNumberRule, blockNumberItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+	var windowRule []interface{}
+	for _, windowItem := range window {
+		windowRule = append(windowRule, windowItem)
+	}
+
+	logs, sub, err := _BlockTracker.contract.FilterLogs(opts, "NewL1Block", blockNumberRule, winnerRule, windowRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerNewL1BlockIterator{contract: _BlockTracker.contract, event: "NewL1Block", logs: logs, sub: sub}, nil
+}
+
+// WatchNewL1Block is a free log subscription operation binding the contract event 0x8323d3e5d25db513e1a772870aaa45e9b069a13d49879d72e70638b5c1c18cb7.
+//
+// Solidity: event NewL1Block(uint256 indexed blockNumber, address indexed winner, uint256 indexed window)
+func (_BlockTracker *BlockTrackerFilterer) WatchNewL1Block(opts *bind.WatchOpts, sink chan<- *BlockTrackerNewL1Block, blockNumber []*big.Int, winner []common.Address, window []*big.Int) (event.Subscription, error) {
+
+	var blockNumberRule []interface{}
+	for _, blockNumberItem := range blockNumber {
+		blockNumberRule = append(blockNumberRule, blockNumberItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+	var windowRule []interface{}
+	for _, windowItem := range window {
+		windowRule = append(windowRule, windowItem)
+	}
+
+	logs, sub, err := _BlockTracker.contract.WatchLogs(opts, "NewL1Block", blockNumberRule, winnerRule, windowRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockTrackerNewL1Block)
+				if err := _BlockTracker.contract.UnpackLog(event, "NewL1Block", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewL1Block is a log parse operation binding the contract event 0x8323d3e5d25db513e1a772870aaa45e9b069a13d49879d72e70638b5c1c18cb7.
+//
+// Solidity: event NewL1Block(uint256 indexed blockNumber, address indexed winner, uint256 indexed window)
+func (_BlockTracker *BlockTrackerFilterer) ParseNewL1Block(log types.Log) (*BlockTrackerNewL1Block, error) {
+	event := new(BlockTrackerNewL1Block)
+	if err := _BlockTracker.contract.UnpackLog(event, "NewL1Block", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BlockTrackerNewWindowIterator is returned from FilterNewWindow and is used to iterate over the raw logs and unpacked data for NewWindow events raised by the BlockTracker contract.
+type BlockTrackerNewWindowIterator struct {
+	Event *BlockTrackerNewWindow // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockTrackerNewWindowIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockTrackerNewWindow)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockTrackerNewWindow)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockTrackerNewWindowIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockTrackerNewWindowIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockTrackerNewWindow represents a NewWindow event raised by the BlockTracker contract.
+type BlockTrackerNewWindow struct {
+	Window *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterNewWindow is a free log retrieval operation binding the contract event 0x6553ddbc9c04d825543b5b531877439a6abdb68d5825c39f2dd3798e54118870.
+//
+// Solidity: event NewWindow(uint256 indexed window)
+func (_BlockTracker *BlockTrackerFilterer) FilterNewWindow(opts *bind.FilterOpts, window []*big.Int) (*BlockTrackerNewWindowIterator, error) {
+
+	var windowRule []interface{}
+	for _, windowItem := range window {
+		windowRule = append(windowRule, windowItem)
+	}
+
+	logs, sub, err := _BlockTracker.contract.FilterLogs(opts, "NewWindow", windowRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerNewWindowIterator{contract: _BlockTracker.contract, event: "NewWindow", logs: logs, sub: sub}, nil
+}
+
+// WatchNewWindow is a free log subscription operation binding the contract event 0x6553ddbc9c04d825543b5b531877439a6abdb68d5825c39f2dd3798e54118870.
+//
+// Solidity: event NewWindow(uint256 indexed window)
+func (_BlockTracker *BlockTrackerFilterer) WatchNewWindow(opts *bind.WatchOpts, sink chan<- *BlockTrackerNewWindow, window []*big.Int) (event.Subscription, error) {
+
+	var windowRule []interface{}
+	for _, windowItem := range window {
+		windowRule = append(windowRule, windowItem)
+	}
+
+	logs, sub, err := _BlockTracker.contract.WatchLogs(opts, "NewWindow", windowRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockTrackerNewWindow)
+				if err := _BlockTracker.contract.UnpackLog(event, "NewWindow", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNewWindow is a log parse operation binding the contract event 0x6553ddbc9c04d825543b5b531877439a6abdb68d5825c39f2dd3798e54118870.
+//
+// Solidity: event NewWindow(uint256 indexed window)
+func (_BlockTracker *BlockTrackerFilterer) ParseNewWindow(log types.Log) (*BlockTrackerNewWindow, error) {
+	event := new(BlockTrackerNewWindow)
+	if err := _BlockTracker.contract.UnpackLog(event, "NewWindow", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BlockTrackerOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the BlockTracker contract.
+type BlockTrackerOwnershipTransferredIterator struct {
+	Event *BlockTrackerOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *BlockTrackerOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(BlockTrackerOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(BlockTrackerOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *BlockTrackerOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *BlockTrackerOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// BlockTrackerOwnershipTransferred represents a OwnershipTransferred event raised by the BlockTracker contract.
+type BlockTrackerOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BlockTracker *BlockTrackerFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BlockTrackerOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BlockTracker.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockTrackerOwnershipTransferredIterator{contract: _BlockTracker.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BlockTracker *BlockTrackerFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BlockTrackerOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _BlockTracker.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(BlockTrackerOwnershipTransferred)
+				if err := _BlockTracker.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_BlockTracker *BlockTrackerFilterer) ParseOwnershipTransferred(log types.Log) (*BlockTrackerOwnershipTransferred, error) {
+	event := new(BlockTrackerOwnershipTransferred)
+	if err := _BlockTracker.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1101 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package l1gateway
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// L1GatewayMetaData contains all meta data concerning the L1Gateway contract.
+var L1GatewayMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"counterpartyFee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finalizationFee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finalizeTransfer\",\"inputs\":[{\"name\":\"_recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_counterpartyIdx\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_relayer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_finalizationFee\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_counterpartyFee\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initiateTransfer\",\"inputs\":[{\"name\":\"_recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"returnIdx\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"relayer\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferFinalizedIdx\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferInitiatedIdx\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferFinalized\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"counterpartyIdx\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferInitiated\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"transferIdx\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+}
+
+// L1GatewayABI is the input ABI used to generate the binding from.
+// Deprecated: Use L1GatewayMetaData.ABI instead.
+var L1GatewayABI = L1GatewayMetaData.ABI
+
+// L1Gateway is an auto generated Go binding around an Ethereum contract.
+type L1Gateway struct {
+	L1GatewayCaller     // Read-only binding to the contract
+	L1GatewayTransactor // Write-only binding to the contract
+	L1GatewayFilterer   // Log filterer for contract events
+}
+
+// L1GatewayCaller is an auto generated read-only Go binding around an Ethereum contract.
+type L1GatewayCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// L1GatewayTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type L1GatewayTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// L1GatewayFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type L1GatewayFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// L1GatewaySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type L1GatewaySession struct {
+	Contract     *L1Gateway        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// L1GatewayCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type L1GatewayCallerSession struct {
+	Contract *L1GatewayCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// L1GatewayTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type L1GatewayTransactorSession struct {
+	Contract     *L1GatewayTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// L1GatewayRaw is an auto generated low-level Go binding around an Ethereum contract.
+type L1GatewayRaw struct {
+	Contract *L1Gateway // Generic contract binding to access the raw methods on
+}
+
+// L1GatewayCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type L1GatewayCallerRaw struct {
+	Contract *L1GatewayCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// L1GatewayTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type L1GatewayTransactorRaw struct {
+	Contract *L1GatewayTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewL1Gateway creates a new instance of L1Gateway, bound to a specific deployed contract.
+func NewL1Gateway(address common.Address, backend bind.ContractBackend) (*L1Gateway, error) {
+	contract, err := bindL1Gateway(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &L1Gateway{L1GatewayCaller: L1GatewayCaller{contract: contract}, L1GatewayTransactor: L1GatewayTransactor{contract: contract}, L1GatewayFilterer: L1GatewayFilterer{contract: contract}}, nil
+}
+
+// NewL1GatewayCaller creates a new read-only instance of L1Gateway, bound to a specific deployed contract.
+func NewL1GatewayCaller(address common.Address, caller bind.ContractCaller) (*L1GatewayCaller, error) {
+	contract, err := bindL1Gateway(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayCaller{contract: contract}, nil
+}
+
+// NewL1GatewayTransactor creates a new write-only instance of L1Gateway, bound to a specific deployed contract.
+func NewL1GatewayTransactor(address common.Address, transactor bind.ContractTransactor) (*L1GatewayTransactor, error) {
+	contract, err := bindL1Gateway(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayTransactor{contract: contract}, nil
+}
+
+// NewL1GatewayFilterer creates a new log filterer instance of L1Gateway, bound to a specific deployed contract.
+func NewL1GatewayFilterer(address common.Address, filterer bind.ContractFilterer) (*L1GatewayFilterer, error) {
+	contract, err := bindL1Gateway(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayFilterer{contract: contract}, nil
+}
+
+// bindL1Gateway binds a generic wrapper to an already deployed contract.
+func bindL1Gateway(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := L1GatewayMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_L1Gateway *L1GatewayRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _L1Gateway.Contract.L1GatewayCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_L1Gateway *L1GatewayRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _L1Gateway.Contract.L1GatewayTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_L1Gateway *L1GatewayRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _L1Gateway.Contract.L1GatewayTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_L1Gateway *L1GatewayCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _L1Gateway.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_L1Gateway *L1GatewayTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _L1Gateway.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_L1Gateway *L1GatewayTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _L1Gateway.Contract.contract.Transact(opts, method, params...)
+}
+
+// CounterpartyFee is a free data retrieval call binding the contract method 0x97599011.
+//
+// Solidity: function counterpartyFee() view returns(uint256)
+func (_L1Gateway *L1GatewayCaller) CounterpartyFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _L1Gateway.contract.Call(opts, &out, "counterpartyFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CounterpartyFee is a free data retrieval call binding the contract method 0x97599011.
+//
+// Solidity: function counterpartyFee() view returns(uint256)
+func (_L1Gateway *L1GatewaySession) CounterpartyFee() (*big.Int, error) {
+	return _L1Gateway.Contract.CounterpartyFee(&_L1Gateway.CallOpts)
+}
+
+// CounterpartyFee is a free data retrieval call binding the contract method 0x97599011.
+//
+// Solidity: function counterpartyFee() view returns(uint256)
+func (_L1Gateway *L1GatewayCallerSession) CounterpartyFee() (*big.Int, error) {
+	return _L1Gateway.Contract.CounterpartyFee(&_L1Gateway.CallOpts)
+}
+
+// FinalizationFee is a free data retrieval call binding the contract method 0x78d3d576.
+//
+// Solidity: function finalizationFee() view returns(uint256)
+func (_L1Gateway *L1GatewayCaller) FinalizationFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _L1Gateway.contract.Call(opts, &out, "finalizationFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FinalizationFee is a free data retrieval call binding the contract method 0x78d3d576.
+//
+// Solidity: function finalizationFee() view returns(uint256)
+func (_L1Gateway *L1GatewaySession) FinalizationFee() (*big.Int, error) {
+	return _L1Gateway.Contract.FinalizationFee(&_L1Gateway.CallOpts)
+}
+
+// FinalizationFee is a free data retrieval call binding the contract method 0x78d3d576.
+//
+// Solidity: function finalizationFee() view returns(uint256)
+func (_L1Gateway *L1GatewayCallerSession) FinalizationFee() (*big.Int, error) {
+	return _L1Gateway.Contract.FinalizationFee(&_L1Gateway.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_L1Gateway *L1GatewayCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _L1Gateway.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_L1Gateway *L1GatewaySession) Owner() (common.Address, error) {
+	return _L1Gateway.Contract.Owner(&_L1Gateway.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_L1Gateway *L1GatewayCallerSession) Owner() (common.Address, error) {
+	return _L1Gateway.Contract.Owner(&_L1Gateway.CallOpts)
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_L1Gateway *L1GatewayCaller) Relayer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _L1Gateway.contract.Call(opts, &out, "relayer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_L1Gateway *L1GatewaySession) Relayer() (common.Address, error) {
+	return _L1Gateway.Contract.Relayer(&_L1Gateway.CallOpts)
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_L1Gateway *L1GatewayCallerSession) Relayer() (common.Address, error) {
+	return _L1Gateway.Contract.Relayer(&_L1Gateway.CallOpts)
+}
+
+// TransferFinalizedIdx is a free data retrieval call binding the contract method 0xa2ff158d.
+//
+// Solidity: function transferFinalizedIdx() view returns(uint256)
+func (_L1Gateway *L1GatewayCaller) TransferFinalizedIdx(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _L1Gateway.contract.Call(opts, &out, "transferFinalizedIdx")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferFinalizedIdx is a free data retrieval call binding the contract method 0xa2ff158d.
+//
+// Solidity: function transferFinalizedIdx() view returns(uint256)
+func (_L1Gateway *L1GatewaySession) TransferFinalizedIdx() (*big.Int, error) {
+	return _L1Gateway.Contract.TransferFinalizedIdx(&_L1Gateway.CallOpts)
+}
+
+// TransferFinalizedIdx is a free data retrieval call binding the contract method 0xa2ff158d.
+//
+// Solidity: function transferFinalizedIdx() view returns(uint256)
+func (_L1Gateway *L1GatewayCallerSession) TransferFinalizedIdx() (*big.Int, error) {
+	return _L1Gateway.Contract.TransferFinalizedIdx(&_L1Gateway.CallOpts)
+}
+
+// TransferInitiatedIdx is a free data retrieval call binding the contract method 0xe557b142.
+//
+// Solidity: function transferInitiatedIdx() view returns(uint256)
+func (_L1Gateway *L1GatewayCaller) TransferInitiatedIdx(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _L1Gateway.contract.Call(opts, &out, "transferInitiatedIdx")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferInitiatedIdx is a free data retrieval call binding the contract method 0xe557b142.
+//
+// Solidity: function transferInitiatedIdx() view returns(uint256)
+func (_L1Gateway *L1GatewaySession) TransferInitiatedIdx() (*big.Int, error) {
+	return _L1Gateway.Contract.TransferInitiatedIdx(&_L1Gateway.CallOpts)
+}
+
+// TransferInitiatedIdx is a free data retrieval call binding the contract method 0xe557b142.
+//
+// Solidity: function transferInitiatedIdx() view returns(uint256)
+func (_L1Gateway *L1GatewayCallerSession) TransferInitiatedIdx() (*big.Int, error) {
+	return _L1Gateway.Contract.TransferInitiatedIdx(&_L1Gateway.CallOpts)
+}
+
+// FinalizeTransfer is a paid mutator transaction binding the contract method 0xc40a7c82.
+//
+// Solidity: function finalizeTransfer(address _recipient, uint256 _amount, uint256 _counterpartyIdx) returns()
+func (_L1Gateway *L1GatewayTransactor) FinalizeTransfer(opts *bind.TransactOpts, _recipient common.Address, _amount *big.Int, _counterpartyIdx *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.contract.Transact(opts, "finalizeTransfer", _recipient, _amount, _counterpartyIdx)
+}
+
+// FinalizeTransfer is a paid mutator transaction binding the contract method 0xc40a7c82.
+//
+// Solidity: function finalizeTransfer(address _recipient, uint256 _amount, uint256 _counterpartyIdx) returns()
+func (_L1Gateway *L1GatewaySession) FinalizeTransfer(_recipient common.Address, _amount *big.Int, _counterpartyIdx *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.Contract.FinalizeTransfer(&_L1Gateway.TransactOpts, _recipient, _amount, _counterpartyIdx)
+}
+
+// FinalizeTransfer is a paid mutator transaction binding the contract method 0xc40a7c82.
+//
+// Solidity: function finalizeTransfer(address _recipient, uint256 _amount, uint256 _counterpartyIdx) returns()
+func (_L1Gateway *L1GatewayTransactorSession) FinalizeTransfer(_recipient common.Address, _amount *big.Int, _counterpartyIdx *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.Contract.FinalizeTransfer(&_L1Gateway.TransactOpts, _recipient, _amount, _counterpartyIdx)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xeb990c59.
+//
+// Solidity: function initialize(address _owner, address _relayer, uint256 _finalizationFee, uint256 _counterpartyFee) returns()
+func (_L1Gateway *L1GatewayTransactor) Initialize(opts *bind.TransactOpts, _owner common.Address, _relayer common.Address, _finalizationFee *big.Int, _counterpartyFee *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.contract.Transact(opts, "initialize", _owner, _relayer, _finalizationFee, _counterpartyFee)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xeb990c59.
+//
+// Solidity: function initialize(address _owner, address _relayer, uint256 _finalizationFee, uint256 _counterpartyFee) returns()
+func (_L1Gateway *L1GatewaySession) Initialize(_owner common.Address, _relayer common.Address, _finalizationFee *big.Int, _counterpartyFee *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.Contract.Initialize(&_L1Gateway.TransactOpts, _owner, _relayer, _finalizationFee, _counterpartyFee)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xeb990c59.
+//
+// Solidity: function initialize(address _owner, address _relayer, uint256 _finalizationFee, uint256 _counterpartyFee) returns()
+func (_L1Gateway *L1GatewayTransactorSession) Initialize(_owner common.Address, _relayer common.Address, _finalizationFee *big.Int, _counterpartyFee *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.Contract.Initialize(&_L1Gateway.TransactOpts, _owner, _relayer, _finalizationFee, _counterpartyFee)
+}
+
+// InitiateTransfer is a paid mutator transaction binding the contract method 0xb504cd1e.
+//
+// Solidity: function initiateTransfer(address _recipient, uint256 _amount) payable returns(uint256 returnIdx)
+func (_L1Gateway *L1GatewayTransactor) InitiateTransfer(opts *bind.TransactOpts, _recipient common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.contract.Transact(opts, "initiateTransfer", _recipient, _amount)
+}
+
+// InitiateTransfer is a paid mutator transaction binding the contract method 0xb504cd1e.
+//
+// Solidity: function initiateTransfer(address _recipient, uint256 _amount) payable returns(uint256 returnIdx)
+func (_L1Gateway *L1GatewaySession) InitiateTransfer(_recipient common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.Contract.InitiateTransfer(&_L1Gateway.TransactOpts, _recipient, _amount)
+}
+
+// InitiateTransfer is a paid mutator transaction binding the contract method 0xb504cd1e.
+//
+// Solidity: function initiateTransfer(address _recipient, uint256 _amount) payable returns(uint256 returnIdx)
+func (_L1Gateway *L1GatewayTransactorSession) InitiateTransfer(_recipient common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _L1Gateway.Contract.InitiateTransfer(&_L1Gateway.TransactOpts, _recipient, _amount)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_L1Gateway *L1GatewayTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _L1Gateway.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_L1Gateway *L1GatewaySession) RenounceOwnership() (*types.Transaction, error) {
+	return _L1Gateway.Contract.RenounceOwnership(&_L1Gateway.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_L1Gateway *L1GatewayTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _L1Gateway.Contract.RenounceOwnership(&_L1Gateway.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_L1Gateway *L1GatewayTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _L1Gateway.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_L1Gateway *L1GatewaySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _L1Gateway.Contract.TransferOwnership(&_L1Gateway.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_L1Gateway *L1GatewayTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _L1Gateway.Contract.TransferOwnership(&_L1Gateway.TransactOpts, newOwner)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_L1Gateway *L1GatewayTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _L1Gateway.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_L1Gateway *L1GatewaySession) Receive() (*types.Transaction, error) {
+	return _L1Gateway.Contract.Receive(&_L1Gateway.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_L1Gateway *L1GatewayTransactorSession) Receive() (*types.Transaction, error) {
+	return _L1Gateway.Contract.Receive(&_L1Gateway.TransactOpts)
+}
+
+// L1GatewayInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the L1Gateway contract.
+type L1GatewayInitializedIterator struct {
+	Event *L1GatewayInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *L1GatewayInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(L1GatewayInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(L1GatewayInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *L1GatewayInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *L1GatewayInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// L1GatewayInitialized represents a Initialized event raised by the L1Gateway contract.
+type L1GatewayInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_L1Gateway *L1GatewayFilterer) FilterInitialized(opts *bind.FilterOpts) (*L1GatewayInitializedIterator, error) {
+
+	logs, sub, err := _L1Gateway.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayInitializedIterator{contract: _L1Gateway.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_L1Gateway *L1GatewayFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *L1GatewayInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _L1Gateway.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(L1GatewayInitialized)
+				if err := _L1Gateway.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_L1Gateway *L1GatewayFilterer) ParseInitialized(log types.Log) (*L1GatewayInitialized, error) {
+	event := new(L1GatewayInitialized)
+	if err := _L1Gateway.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// L1GatewayOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the L1Gateway contract.
+type L1GatewayOwnershipTransferredIterator struct {
+	Event *L1GatewayOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *L1GatewayOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(L1GatewayOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(L1GatewayOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *L1GatewayOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *L1GatewayOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// L1GatewayOwnershipTransferred represents a OwnershipTransferred event raised by the L1Gateway contract.
+type L1GatewayOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_L1Gateway *L1GatewayFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*L1GatewayOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _L1Gateway.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayOwnershipTransferredIterator{contract: _L1Gateway.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_L1Gateway *L1GatewayFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *L1GatewayOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _L1Gateway.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(L1GatewayOwnershipTransferred)
+				if err := _L1Gateway.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_L1Gateway *L1GatewayFilterer) ParseOwnershipTransferred(log types.Log) (*L1GatewayOwnershipTransferred, error) {
+	event := new(L1GatewayOwnershipTransferred)
+	if err := _L1Gateway.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// L1GatewayTransferFinalizedIterator is returned from FilterTransferFinalized and is used to iterate over the raw logs and unpacked data for TransferFinalized events raised by the L1Gateway contract.
+type L1GatewayTransferFinalizedIterator struct {
+	Event *L1GatewayTransferFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *L1GatewayTransferFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(L1GatewayTransferFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(L1GatewayTransferFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *L1GatewayTransferFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *L1GatewayTransferFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// L1GatewayTransferFinalized represents a TransferFinalized event raised by the L1Gateway contract.
+type L1GatewayTransferFinalized struct {
+	Recipient       common.Address
+	Amount          *big.Int
+	CounterpartyIdx *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferFinalized is a free log retrieval operation binding the contract event 0x8c1419715bf9fd4efa8c0fd1833ba527cbdd86f6018aa79102af32103bbfdefd.
+//
+// Solidity: event TransferFinalized(address indexed recipient, uint256 amount, uint256 indexed counterpartyIdx)
+func (_L1Gateway *L1GatewayFilterer) FilterTransferFinalized(opts *bind.FilterOpts, recipient []common.Address, counterpartyIdx []*big.Int) (*L1GatewayTransferFinalizedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var counterpartyIdxRule []interface{}
+	for _, counterpartyIdxItem := range counterpartyIdx {
+		counterpartyIdxRule = append(counterpartyIdxRule, counterpartyIdxItem)
+	}
+
+	logs, sub, err := _L1Gateway.contract.FilterLogs(opts, "TransferFinalized", recipientRule, counterpartyIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayTransferFinalizedIterator{contract: _L1Gateway.contract, event: "TransferFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferFinalized is a free log subscription operation binding the contract event 0x8c1419715bf9fd4efa8c0fd1833ba527cbdd86f6018aa79102af32103bbfdefd.
+//
+// Solidity: event TransferFinalized(address indexed recipient, uint256 amount, uint256 indexed counterpartyIdx)
+func (_L1Gateway *L1GatewayFilterer) WatchTransferFinalized(opts *bind.WatchOpts, sink chan<- *L1GatewayTransferFinalized, recipient []common.Address, counterpartyIdx []*big.Int) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var counterpartyIdxRule []interface{}
+	for _, counterpartyIdxItem := range counterpartyIdx {
+		counterpartyIdxRule = append(counterpartyIdxRule, counterpartyIdxItem)
+	}
+
+	logs, sub, err := _L1Gateway.contract.WatchLogs(opts, "TransferFinalized", recipientRule, counterpartyIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(L1GatewayTransferFinalized)
+				if err := _L1Gateway.contract.UnpackLog(event, "TransferFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferFinalized is a log parse operation binding the contract event 0x8c1419715bf9fd4efa8c0fd1833ba527cbdd86f6018aa79102af32103bbfdefd.
+//
+// Solidity: event TransferFinalized(address indexed recipient, uint256 amount, uint256 indexed counterpartyIdx)
+func (_L1Gateway *L1GatewayFilterer) ParseTransferFinalized(log types.Log) (*L1GatewayTransferFinalized, error) {
+	event := new(L1GatewayTransferFinalized)
+	if err := _L1Gateway.contract.UnpackLog(event, "TransferFinalized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// L1GatewayTransferInitiatedIterator is returned from FilterTransferInitiated and is used to iterate over the raw logs and unpacked data for TransferInitiated events raised by the L1Gateway contract.
+type L1GatewayTransferInitiatedIterator struct {
+	Event *L1GatewayTransferInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *L1GatewayTransferInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(L1GatewayTransferInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(L1GatewayTransferInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *L1GatewayTransferInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *L1GatewayTransferInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// L1GatewayTransferInitiated represents a TransferInitiated event raised by the L1Gateway contract.
+type L1GatewayTransferInitiated struct {
+	Sender      common.Address
+	Recipient   common.Address
+	Amount      *big.Int
+	TransferIdx *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferInitiated is a free log retrieval operation binding the contract event 0x6abe792a4e9e702afbc17fdac3c94f6ed1d8c9a8e4917c99672474b3f775ab43.
+//
+// Solidity: event TransferInitiated(address indexed sender, address indexed recipient, uint256 amount, uint256 indexed transferIdx)
+func (_L1Gateway *L1GatewayFilterer) FilterTransferInitiated(opts *bind.FilterOpts, sender []common.Address, recipient []common.Address, transferIdx []*big.Int) (*L1GatewayTransferInitiatedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var transferIdxRule []interface{}
+	for _, transferIdxItem := range transferIdx {
+		transferIdxRule = append(transferIdxRule, transferIdxItem)
+	}
+
+	logs, sub, err := _L1Gateway.contract.FilterLogs(opts, "TransferInitiated", senderRule, recipientRule, transferIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return &L1GatewayTransferInitiatedIterator{contract: _L1Gateway.contract, event: "TransferInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferInitiated is a free log subscription operation binding the contract event 0x6abe792a4e9e702afbc17fdac3c94f6ed1d8c9a8e4917c99672474b3f775ab43.
+//
+// Solidity: event TransferInitiated(address indexed sender, address indexed recipient, uint256 amount, uint256 indexed transferIdx)
+func (_L1Gateway *L1GatewayFilterer) WatchTransferInitiated(opts *bind.WatchOpts, sink chan<- *L1GatewayTransferInitiated, sender []common.Address, recipient []common.Address, transferIdx []*big.Int) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var transferIdxRule []interface{}
+	for _, transferIdxItem := range transferIdx {
+		transferIdxRule = append(transferIdxRule, transferIdxItem)
+	}
+
+	logs, sub, err := _L1Gateway.contract.WatchLogs(opts, "TransferInitiated", senderRule, recipientRule, transferIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(L1GatewayTransferInitiated)
+				if err := _L1Gateway.contract.UnpackLog(event, "TransferInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferInitiated is a log parse operation binding the contract event 0x6abe792a4e9e702afbc17fdac3c94f6ed1d8c9a8e4917c99672474b3f775ab43.
+//
+// Solidity: event TransferInitiated(address indexed sender, address indexed recipient, uint256 amount, uint256 indexed transferIdx)
+func (_L1Gateway *L1GatewayFilterer) ParseTransferInitiated(log types.Log) (*L1GatewayTransferInitiated, error) {
+	event := new(L1GatewayTransferInitiated)
+	if err := _L1Gateway.contract.UnpackLog(event, "TransferInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,2105 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package preconfcommitmentstore
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// PreConfCommitmentStoreEncrPreConfCommitment is an auto generated low-level Go binding around an user-defined struct.
+type PreConfCommitmentStoreEncrPreConfCommitment struct {
+	IsUsed              bool
+	Commiter            common.Address
+	CommitmentDigest    [32]byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+}
+
+// PreConfCommitmentStorePreConfCommitment is an auto generated low-level Go binding around an user-defined struct.
+type PreConfCommitmentStorePreConfCommitment struct {
+	IsUsed              bool
+	Bidder              common.Address
+	Commiter            common.Address
+	Bid                 uint64
+	BlockNumber         uint64
+	BidHash             [32]byte
+	DecayStartTimeStamp uint64
+	DecayEndTimeStamp   uint64
+	TxnHash             string
+	CommitmentHash      [32]byte
+	BidSignature        []byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+	SharedSecretKey     []byte
+}
+
+// PreConfCommitmentStoreMetaData contains all meta data concerning the PreConfCommitmentStore contract.
+var PreConfCommitmentStoreMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"fallback\",\"stateMutability\":\"payable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR_BID\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR_PRECONF\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"EIP712_BID_TYPEHASH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"EIP712_COMMITMENT_TYPEHASH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"_bytesToHexString\",\"inputs\":[{\"name\":\"_bytes\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"bidderRegistry\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIBidderRegistry\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"blockTracker\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIBlockTracker\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"commitmentDispatchWindow\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"commitments\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"isUsed\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"commiter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"bidHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commitmentHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"sharedSecretKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"commitmentsCount\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"encryptedCommitments\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"isUsed\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"commiter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBidHash\",\"inputs\":[{\"name\":\"_txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCommitment\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structPreConfCommitmentStore.PreConfCommitment\",\"components\":[{\"name\":\"isUsed\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"commiter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"bidHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commitmentHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"sharedSecretKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCommitmentIndex\",\"inputs\":[{\"name\":\"commitment\",\"type\":\"tuple\",\"internalType\":\"structPreConfCommitmentStore.PreConfCommitment\",\"components\":[{\"name\":\"isUsed\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"commiter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"bidHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commitmentHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"sharedSecretKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getEncryptedCommitment\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structPreConfCommitmentStore.EncrPreConfCommitment\",\"components\":[{\"name\":\"isUsed\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"commiter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getEncryptedCommitmentIndex\",\"inputs\":[{\"name\":\"commitment\",\"type\":\"tuple\",\"internalType\":\"structPreConfCommitmentStore.EncrPreConfCommitment\",\"components\":[{\"name\":\"isUsed\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"commiter\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getPreConfHash\",\"inputs\":[{\"name\":\"_txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"_bidHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"_bidSignature\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"_sharedSecretKey\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTxnHashFromCommitment\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_providerRegistry\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_bidderRegistry\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_oracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_blockTracker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_commitmentDispatchWindow\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initiateReward\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"residualBidPercentAfterDecay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initiateSlash\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"residualBidPercentAfterDecay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"lastProcessedBlock\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"openCommitment\",\"inputs\":[{\"name\":\"encryptedCommitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"sharedSecretKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"oracle\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"providerRegistry\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIProviderRegistry\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"storeEncryptedCommitment\",\"inputs\":[{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateBidderRegistry\",\"inputs\":[{\"name\":\"newBidderRegistry\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateCommitmentDispatchWindow\",\"inputs\":[{\"name\":\"newDispatchWindow\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateOracle\",\"inputs\":[{\"name\":\"newOracle\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"updateProviderRegistry\",\"inputs\":[{\"name\":\"newProviderRegistry\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"verifyBid\",\"inputs\":[{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"messageDigest\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"recoveredAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"verifyPreConfCommitment\",\"inputs\":[{\"name\":\"txnHash\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"bid\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"bidHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"sharedSecretKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"preConfHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"commiterAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"CommitmentStored\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"bidder\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"commiter\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"bid\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"bidHash\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"},{\"name\":\"decayStartTimeStamp\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"decayEndTimeStamp\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"txnHash\",\"type\":\"string\",\"indexed\":false,\"internalType\":\"string\"},{\"name\":\"commitmentHash\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"},{\"name\":\"bidSignature\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"},{\"name\":\"sharedSecretKey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EncryptedCommitmentStored\",\"inputs\":[{\"name\":\"commitmentIndex\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"commiter\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"commitmentDigest\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"},{\"name\":\"commitmentSignature\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"dispatchTimestamp\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SignatureVerified\",\"inputs\":[{\"name\":\"signer\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"txnHash\",\"type\":\"string\",\"indexed\":false,\"internalType\":\"string\"},{\"name\":\"bid\",\"type\":\"uint64\",\"indexed\":true,\"internalType\":\"uint64\"},{\"name\":\"blockNumber\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+}
+
+// PreConfCommitmentStoreABI is the input ABI used to generate the binding from.
+// Deprecated: Use PreConfCommitmentStoreMetaData.ABI instead.
+var PreConfCommitmentStoreABI = PreConfCommitmentStoreMetaData.ABI
+
+// PreConfCommitmentStore is an auto generated Go binding around an Ethereum contract.
+type PreConfCommitmentStore struct {
+	PreConfCommitmentStoreCaller     // Read-only binding to the contract
+	PreConfCommitmentStoreTransactor // Write-only binding to the contract
+	PreConfCommitmentStoreFilterer   // Log filterer for contract events
+}
+
+// PreConfCommitmentStoreCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PreConfCommitmentStoreCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PreConfCommitmentStoreTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PreConfCommitmentStoreTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PreConfCommitmentStoreFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PreConfCommitmentStoreFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PreConfCommitmentStoreSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PreConfCommitmentStoreSession struct {
+	Contract     *PreConfCommitmentStore // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// PreConfCommitmentStoreCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PreConfCommitmentStoreCallerSession struct {
+	Contract *PreConfCommitmentStoreCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// PreConfCommitmentStoreTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PreConfCommitmentStoreTransactorSession struct {
+	Contract     *PreConfCommitmentStoreTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// PreConfCommitmentStoreRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PreConfCommitmentStoreRaw struct {
+	Contract *PreConfCommitmentStore // Generic contract binding to access the raw methods on
+}
+
+// PreConfCommitmentStoreCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PreConfCommitmentStoreCallerRaw struct {
+	Contract *PreConfCommitmentStoreCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PreConfCommitmentStoreTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PreConfCommitmentStoreTransactorRaw struct {
+	Contract *PreConfCommitmentStoreTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPreConfCommitmentStore creates a new instance of PreConfCommitmentStore, bound to a specific deployed contract.
+func NewPreConfCommitmentStore(address common.Address, backend bind.ContractBackend) (*PreConfCommitmentStore, error) {
+	contract, err := bindPreConfCommitmentStore(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStore{PreConfCommitmentStoreCaller: PreConfCommitmentStoreCaller{contract: contract}, PreConfCommitmentStoreTransactor: PreConfCommitmentStoreTransactor{contract: contract}, PreConfCommitmentStoreFilterer: PreConfCommitmentStoreFilterer{contract: contract}}, nil
+}
+
+// NewPreConfCommitmentStoreCaller creates a new read-only instance of PreConfCommitmentStore, bound to a specific deployed contract.
+func NewPreConfCommitmentStoreCaller(address common.Address, caller bind.ContractCaller) (*PreConfCommitmentStoreCaller, error) {
+	contract, err := bindPreConfCommitmentStore(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreCaller{contract: contract}, nil
+}
+
+// NewPreConfCommitmentStoreTransactor creates a new write-only instance of PreConfCommitmentStore, bound to a specific deployed contract.
+func NewPreConfCommitmentStoreTransactor(address common.Address, transactor bind.ContractTransactor) (*PreConfCommitmentStoreTransactor, error) {
+	contract, err := bindPreConfCommitmentStore(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreTransactor{contract: contract}, nil
+}
+
+// NewPreConfCommitmentStoreFilterer creates a new log filterer instance of PreConfCommitmentStore, bound to a specific deployed contract.
+func NewPreConfCommitmentStoreFilterer(address common.Address, filterer bind.ContractFilterer) (*PreConfCommitmentStoreFilterer, error) {
+	contract, err := bindPreConfCommitmentStore(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreFilterer{contract: contract}, nil
+}
+
+// bindPreConfCommitmentStore binds a generic wrapper to an already deployed contract.
+func bindPreConfCommitmentStore(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PreConfCommitmentStoreMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PreConfCommitmentStore *PreConfCommitmentStoreRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PreConfCommitmentStore.Contract.PreConfCommitmentStoreCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PreConfCommitmentStore *PreConfCommitmentStoreRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.PreConfCommitmentStoreTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PreConfCommitmentStore *PreConfCommitmentStoreRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.PreConfCommitmentStoreTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PreConfCommitmentStore.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATORBID is a free data retrieval call binding the contract method 0x940b5765.
+//
+// Solidity: function DOMAIN_SEPARATOR_BID() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) DOMAINSEPARATORBID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "DOMAIN_SEPARATOR_BID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATORBID is a free data retrieval call binding the contract method 0x940b5765.
+//
+// Solidity: function DOMAIN_SEPARATOR_BID() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) DOMAINSEPARATORBID() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.DOMAINSEPARATORBID(&_PreConfCommitmentStore.CallOpts)
+}
+
+// DOMAINSEPARATORBID is a free data retrieval call binding the contract method 0x940b5765.
+//
+// Solidity: function DOMAIN_SEPARATOR_BID() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) DOMAINSEPARATORBID() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.DOMAINSEPARATORBID(&_PreConfCommitmentStore.CallOpts)
+}
+
+// DOMAINSEPARATORPRECONF is a free data retrieval call binding the contract method 0xe5ae370f.
+//
+// Solidity: function DOMAIN_SEPARATOR_PRECONF() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) DOMAINSEPARATORPRECONF(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "DOMAIN_SEPARATOR_PRECONF")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATORPRECONF is a free data retrieval call binding the contract method 0xe5ae370f.
+//
+// Solidity: function DOMAIN_SEPARATOR_PRECONF() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) DOMAINSEPARATORPRECONF() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.DOMAINSEPARATORPRECONF(&_PreConfCommitmentStore.CallOpts)
+}
+
+// DOMAINSEPARATORPRECONF is a free data retrieval call binding the contract method 0xe5ae370f.
+//
+// Solidity: function DOMAIN_SEPARATOR_PRECONF() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) DOMAINSEPARATORPRECONF() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.DOMAINSEPARATORPRECONF(&_PreConfCommitmentStore.CallOpts)
+}
+
+// EIP712BIDTYPEHASH is a free data retrieval call binding the contract method 0x517aa8b7.
+//
+// Solidity: function EIP712_BID_TYPEHASH() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) EIP712BIDTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "EIP712_BID_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// EIP712BIDTYPEHASH is a free data retrieval call binding the contract method 0x517aa8b7.
+//
+// Solidity: function EIP712_BID_TYPEHASH() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) EIP712BIDTYPEHASH() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.EIP712BIDTYPEHASH(&_PreConfCommitmentStore.CallOpts)
+}
+
+// EIP712BIDTYPEHASH is a free data retrieval call binding the contract method 0x517aa8b7.
+//
+// Solidity: function EIP712_BID_TYPEHASH() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) EIP712BIDTYPEHASH() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.EIP712BIDTYPEHASH(&_PreConfCommitmentStore.CallOpts)
+}
+
+// EIP712COMMITMENTTYPEHASH is a free data retrieval call binding the contract method 0x10ce6471.
+//
+// Solidity: function EIP712_COMMITMENT_TYPEHASH() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) EIP712COMMITMENTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "EIP712_COMMITMENT_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// EIP712COMMITMENTTYPEHASH is a free data retrieval call binding the contract method 0x10ce6471.
+//
+// Solidity: function EIP712_COMMITMENT_TYPEHASH() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) EIP712COMMITMENTTYPEHASH() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.EIP712COMMITMENTTYPEHASH(&_PreConfCommitmentStore.CallOpts)
+}
+
+// EIP712COMMITMENTTYPEHASH is a free data retrieval call binding the contract method 0x10ce6471.
+//
+// Solidity: function EIP712_COMMITMENT_TYPEHASH() view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) EIP712COMMITMENTTYPEHASH() ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.EIP712COMMITMENTTYPEHASH(&_PreConfCommitmentStore.CallOpts)
+}
+
+// BytesToHexString is a free data retrieval call binding the contract method 0xca64db2e.
+//
+// Solidity: function _bytesToHexString(bytes _bytes) pure returns(string)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) BytesToHexString(opts *bind.CallOpts, _bytes []byte) (string, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "_bytesToHexString", _bytes)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// BytesToHexString is a free data retrieval call binding the contract method 0xca64db2e.
+//
+// Solidity: function _bytesToHexString(bytes _bytes) pure returns(string)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) BytesToHexString(_bytes []byte) (string, error) {
+	return _PreConfCommitmentStore.Contract.BytesToHexString(&_PreConfCommitmentStore.CallOpts, _bytes)
+}
+
+// BytesToHexString is a free data retrieval call binding the contract method 0xca64db2e.
+//
+// Solidity: function _bytesToHexString(bytes _bytes) pure returns(string)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) BytesToHexString(_bytes []byte) (string, error) {
+	return _PreConfCommitmentStore.Contract.BytesToHexString(&_PreConfCommitmentStore.CallOpts, _bytes)
+}
+
+// BidderRegistry is a free data retrieval call binding the contract method 0x909e54e2.
+//
+// Solidity: function bidderRegistry() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) BidderRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "bidderRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BidderRegistry is a free data retrieval call binding the contract method 0x909e54e2.
+//
+// Solidity: function bidderRegistry() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) BidderRegistry() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.BidderRegistry(&_PreConfCommitmentStore.CallOpts)
+}
+
+// BidderRegistry is a free data retrieval call binding the contract method 0x909e54e2.
+//
+// Solidity: function bidderRegistry() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) BidderRegistry() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.BidderRegistry(&_PreConfCommitmentStore.CallOpts)
+}
+
+// BlockTracker is a free data retrieval call binding the contract method 0x381c1d6c.
+//
+// Solidity: function blockTracker() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) BlockTracker(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "blockTracker")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// BlockTracker is a free data retrieval call binding the contract method 0x381c1d6c.
+//
+// Solidity: function blockTracker() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) BlockTracker() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.BlockTracker(&_PreConfCommitmentStore.CallOpts)
+}
+
+// BlockTracker is a free data retrieval call binding the contract method 0x381c1d6c.
+//
+// Solidity: function blockTracker() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) BlockTracker() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.BlockTracker(&_PreConfCommitmentStore.CallOpts)
+}
+
+// CommitmentDispatchWindow is a free data retrieval call binding the contract method 0xf2357c03.
+//
+// Solidity: function commitmentDispatchWindow() view returns(uint64)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) CommitmentDispatchWindow(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "commitmentDispatchWindow")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// CommitmentDispatchWindow is a free data retrieval call binding the contract method 0xf2357c03.
+//
+// Solidity: function commitmentDispatchWindow() view returns(uint64)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) CommitmentDispatchWindow() (uint64, error) {
+	return _PreConfCommitmentStore.Contract.CommitmentDispatchWindow(&_PreConfCommitmentStore.CallOpts)
+}
+
+// CommitmentDispatchWindow is a free data retrieval call binding the contract method 0xf2357c03.
+//
+// Solidity: function commitmentDispatchWindow() view returns(uint64)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) CommitmentDispatchWindow() (uint64, error) {
+	return _PreConfCommitmentStore.Contract.CommitmentDispatchWindow(&_PreConfCommitmentStore.CallOpts)
+}
+
+// Commitments is a free data retrieval call binding the contract method 0x839df945.
+//
+// Solidity: function commitments(bytes32 ) view returns(bool isUsed, address bidder, address commiter, uint64 bid, uint64 blockNumber, bytes32 bidHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes32 commitmentHash, bytes bidSignature, bytes commitmentSignature, uint64 dispatchTimestamp, bytes sharedSecretKey)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) Commitments(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	IsUsed              bool
+	Bidder              common.Address
+	Commiter            common.Address
+	Bid                 uint64
+	BlockNumber         uint64
+	BidHash             [32]byte
+	DecayStartTimeStamp uint64
+	DecayEndTimeStamp   uint64
+	TxnHash             string
+	CommitmentHash      [32]byte
+	BidSignature        []byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+	SharedSecretKey     []byte
+}, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "commitments", arg0)
+
+	outstruct := new(struct {
+		IsUsed              bool
+		Bidder              common.Address
+		Commiter            common.Address
+		Bid                 uint64
+		BlockNumber         uint64
+		BidHash             [32]byte
+		DecayStartTimeStamp uint64
+		DecayEndTimeStamp   uint64
+		TxnHash             string
+		CommitmentHash      [32]byte
+		BidSignature        []byte
+		CommitmentSignature []byte
+		DispatchTimestamp   uint64
+		SharedSecretKey     []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.IsUsed = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Bidder = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Commiter = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.Bid = *abi.ConvertType(out[3], new(uint64)).(*uint64)
+	outstruct.BlockNumber = *abi.ConvertType(out[4], new(uint64)).(*uint64)
+	outstruct.BidHash = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.DecayStartTimeStamp = *abi.ConvertType(out[6], new(uint64)).(*uint64)
+	outstruct.DecayEndTimeStamp = *abi.ConvertType(out[7], new(uint64)).(*uint64)
+	outstruct.TxnHash = *abi.ConvertType(out[8], new(string)).(*string)
+	outstruct.CommitmentHash = *abi.ConvertType(out[9], new([32]byte)).(*[32]byte)
+	outstruct.BidSignature = *abi.ConvertType(out[10], new([]byte)).(*[]byte)
+	outstruct.CommitmentSignature = *abi.ConvertType(out[11], new([]byte)).(*[]byte)
+	outstruct.DispatchTimestamp = *abi.ConvertType(out[12], new(uint64)).(*uint64)
+	outstruct.SharedSecretKey = *abi.ConvertType(out[13], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// Commitments is a free data retrieval call binding the contract method 0x839df945.
+//
+// Solidity: function commitments(bytes32 ) view returns(bool isUsed, address bidder, address commiter, uint64 bid, uint64 blockNumber, bytes32 bidHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes32 commitmentHash, bytes bidSignature, bytes commitmentSignature, uint64 dispatchTimestamp, bytes sharedSecretKey)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) Commitments(arg0 [32]byte) (struct {
+	IsUsed              bool
+	Bidder              common.Address
+	Commiter            common.Address
+	Bid                 uint64
+	BlockNumber         uint64
+	BidHash             [32]byte
+	DecayStartTimeStamp uint64
+	DecayEndTimeStamp   uint64
+	TxnHash             string
+	CommitmentHash      [32]byte
+	BidSignature        []byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+	SharedSecretKey     []byte
+}, error) {
+	return _PreConfCommitmentStore.Contract.Commitments(&_PreConfCommitmentStore.CallOpts, arg0)
+}
+
+// Commitments is a free data retrieval call binding the contract method 0x839df945.
+//
+// Solidity: function commitments(bytes32 ) view returns(bool isUsed, address bidder, address commiter, uint64 bid, uint64 blockNumber, bytes32 bidHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes32 commitmentHash, bytes bidSignature, bytes commitmentSignature, uint64 dispatchTimestamp, bytes sharedSecretKey)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) Commitments(arg0 [32]byte) (struct {
+	IsUsed              bool
+	Bidder              common.Address
+	Commiter            common.Address
+	Bid                 uint64
+	BlockNumber         uint64
+	BidHash             [32]byte
+	DecayStartTimeStamp uint64
+	DecayEndTimeStamp   uint64
+	TxnHash             string
+	CommitmentHash      [32]byte
+	BidSignature        []byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+	SharedSecretKey     []byte
+}, error) {
+	return _PreConfCommitmentStore.Contract.Commitments(&_PreConfCommitmentStore.CallOpts, arg0)
+}
+
+// CommitmentsCount is a free data retrieval call binding the contract method 0x25f5cf21.
+//
+// Solidity: function commitmentsCount(address ) view returns(uint256)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) CommitmentsCount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "commitmentsCount", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CommitmentsCount is a free data retrieval call binding the contract method 0x25f5cf21.
+//
+// Solidity: function commitmentsCount(address ) view returns(uint256)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) CommitmentsCount(arg0 common.Address) (*big.Int, error) {
+	return _PreConfCommitmentStore.Contract.CommitmentsCount(&_PreConfCommitmentStore.CallOpts, arg0)
+}
+
+// CommitmentsCount is a free data retrieval call binding the contract method 0x25f5cf21.
+//
+// Solidity: function commitmentsCount(address ) view returns(uint256)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) CommitmentsCount(arg0 common.Address) (*big.Int, error) {
+	return _PreConfCommitmentStore.Contract.CommitmentsCount(&_PreConfCommitmentStore.CallOpts, arg0)
+}
+
+// EncryptedCommitments is a free data retrieval call binding the contract method 0x566f998c.
+//
+// Solidity: function encryptedCommitments(bytes32 ) view returns(bool isUsed, address commiter, bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) EncryptedCommitments(opts *bind.CallOpts, arg0 [32]byte) (struct {
+	IsUsed              bool
+	Commiter            common.Address
+	CommitmentDigest    [32]byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+}, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "encryptedCommitments", arg0)
+
+	outstruct := new(struct {
+		IsUsed              bool
+		Commiter            common.Address
+		CommitmentDigest    [32]byte
+		CommitmentSignature []byte
+		DispatchTimestamp   uint64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.IsUsed = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Commiter = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.CommitmentDigest = *abi.ConvertType(out[2], new([32]byte)).(*[32]byte)
+	outstruct.CommitmentSignature = *abi.ConvertType(out[3], new([]byte)).(*[]byte)
+	outstruct.DispatchTimestamp = *abi.ConvertType(out[4], new(uint64)).(*uint64)
+
+	return *outstruct, err
+
+}
+
+// EncryptedCommitments is a free data retrieval call binding the contract method 0x566f998c.
+//
+// Solidity: function encryptedCommitments(bytes32 ) view returns(bool isUsed, address commiter, bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) EncryptedCommitments(arg0 [32]byte) (struct {
+	IsUsed              bool
+	Commiter            common.Address
+	CommitmentDigest    [32]byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+}, error) {
+	return _PreConfCommitmentStore.Contract.EncryptedCommitments(&_PreConfCommitmentStore.CallOpts, arg0)
+}
+
+// EncryptedCommitments is a free data retrieval call binding the contract method 0x566f998c.
+//
+// Solidity: function encryptedCommitments(bytes32 ) view returns(bool isUsed, address commiter, bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) EncryptedCommitments(arg0 [32]byte) (struct {
+	IsUsed              bool
+	Commiter            common.Address
+	CommitmentDigest    [32]byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+}, error) {
+	return _PreConfCommitmentStore.Contract.EncryptedCommitments(&_PreConfCommitmentStore.CallOpts, arg0)
+}
+
+// GetBidHash is a free data retrieval call binding the contract method 0x7b2111f6.
+//
+// Solidity: function getBidHash(string _txnHash, uint64 _bid, uint64 _blockNumber, uint64 _decayStartTimeStamp, uint64 _decayEndTimeStamp) view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetBidHash(opts *bind.CallOpts, _txnHash string, _bid uint64, _blockNumber uint64, _decayStartTimeStamp uint64, _decayEndTimeStamp uint64) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getBidHash", _txnHash, _bid, _blockNumber, _decayStartTimeStamp, _decayEndTimeStamp)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetBidHash is a free data retrieval call binding the contract method 0x7b2111f6.
+//
+// Solidity: function getBidHash(string _txnHash, uint64 _bid, uint64 _blockNumber, uint64 _decayStartTimeStamp, uint64 _decayEndTimeStamp) view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetBidHash(_txnHash string, _bid uint64, _blockNumber uint64, _decayStartTimeStamp uint64, _decayEndTimeStamp uint64) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetBidHash(&_PreConfCommitmentStore.CallOpts, _txnHash, _bid, _blockNumber, _decayStartTimeStamp, _decayEndTimeStamp)
+}
+
+// GetBidHash is a free data retrieval call binding the contract method 0x7b2111f6.
+//
+// Solidity: function getBidHash(string _txnHash, uint64 _bid, uint64 _blockNumber, uint64 _decayStartTimeStamp, uint64 _decayEndTimeStamp) view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetBidHash(_txnHash string, _bid uint64, _blockNumber uint64, _decayStartTimeStamp uint64, _decayEndTimeStamp uint64) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetBidHash(&_PreConfCommitmentStore.CallOpts, _txnHash, _bid, _blockNumber, _decayStartTimeStamp, _decayEndTimeStamp)
+}
+
+// GetCommitment is a free data retrieval call binding the contract method 0x7795820c.
+//
+// Solidity: function getCommitment(bytes32 commitmentIndex) view returns((bool,address,address,uint64,uint64,bytes32,uint64,uint64,string,bytes32,bytes,bytes,uint64,bytes))
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetCommitment(opts *bind.CallOpts, commitmentIndex [32]byte) (PreConfCommitmentStorePreConfCommitment, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getCommitment", commitmentIndex)
+
+	if err != nil {
+		return *new(PreConfCommitmentStorePreConfCommitment), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(PreConfCommitmentStorePreConfCommitment)).(*PreConfCommitmentStorePreConfCommitment)
+
+	return out0, err
+
+}
+
+// GetCommitment is a free data retrieval call binding the contract method 0x7795820c.
+//
+// Solidity: function getCommitment(bytes32 commitmentIndex) view returns((bool,address,address,uint64,uint64,bytes32,uint64,uint64,string,bytes32,bytes,bytes,uint64,bytes))
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetCommitment(commitmentIndex [32]byte) (PreConfCommitmentStorePreConfCommitment, error) {
+	return _PreConfCommitmentStore.Contract.GetCommitment(&_PreConfCommitmentStore.CallOpts, commitmentIndex)
+}
+
+// GetCommitment is a free data retrieval call binding the contract method 0x7795820c.
+//
+// Solidity: function getCommitment(bytes32 commitmentIndex) view returns((bool,address,address,uint64,uint64,bytes32,uint64,uint64,string,bytes32,bytes,bytes,uint64,bytes))
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetCommitment(commitmentIndex [32]byte) (PreConfCommitmentStorePreConfCommitment, error) {
+	return _PreConfCommitmentStore.Contract.GetCommitment(&_PreConfCommitmentStore.CallOpts, commitmentIndex)
+}
+
+// GetCommitmentIndex is a free data retrieval call binding the contract method 0xc6687724.
+//
+// Solidity: function getCommitmentIndex((bool,address,address,uint64,uint64,bytes32,uint64,uint64,string,bytes32,bytes,bytes,uint64,bytes) commitment) pure returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetCommitmentIndex(opts *bind.CallOpts, commitment PreConfCommitmentStorePreConfCommitment) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getCommitmentIndex", commitment)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetCommitmentIndex is a free data retrieval call binding the contract method 0xc6687724.
+//
+// Solidity: function getCommitmentIndex((bool,address,address,uint64,uint64,bytes32,uint64,uint64,string,bytes32,bytes,bytes,uint64,bytes) commitment) pure returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetCommitmentIndex(commitment PreConfCommitmentStorePreConfCommitment) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetCommitmentIndex(&_PreConfCommitmentStore.CallOpts, commitment)
+}
+
+// GetCommitmentIndex is a free data retrieval call binding the contract method 0xc6687724.
+//
+// Solidity: function getCommitmentIndex((bool,address,address,uint64,uint64,bytes32,uint64,uint64,string,bytes32,bytes,bytes,uint64,bytes) commitment) pure returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetCommitmentIndex(commitment PreConfCommitmentStorePreConfCommitment) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetCommitmentIndex(&_PreConfCommitmentStore.CallOpts, commitment)
+}
+
+// GetEncryptedCommitment is a free data retrieval call binding the contract method 0x1725b4a7.
+//
+// Solidity: function getEncryptedCommitment(bytes32 commitmentIndex) view returns((bool,address,bytes32,bytes,uint64))
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetEncryptedCommitment(opts *bind.CallOpts, commitmentIndex [32]byte) (PreConfCommitmentStoreEncrPreConfCommitment, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getEncryptedCommitment", commitmentIndex)
+
+	if err != nil {
+		return *new(PreConfCommitmentStoreEncrPreConfCommitment), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(PreConfCommitmentStoreEncrPreConfCommitment)).(*PreConfCommitmentStoreEncrPreConfCommitment)
+
+	return out0, err
+
+}
+
+// GetEncryptedCommitment is a free data retrieval call binding the contract method 0x1725b4a7.
+//
+// Solidity: function getEncryptedCommitment(bytes32 commitmentIndex) view returns((bool,address,bytes32,bytes,uint64))
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetEncryptedCommitment(commitmentIndex [32]byte) (PreConfCommitmentStoreEncrPreConfCommitment, error) {
+	return _PreConfCommitmentStore.Contract.GetEncryptedCommitment(&_PreConfCommitmentStore.CallOpts, commitmentIndex)
+}
+
+// GetEncryptedCommitment is a free data retrieval call binding the contract method 0x1725b4a7.
+//
+// Solidity: function getEncryptedCommitment(bytes32 commitmentIndex) view returns((bool,address,bytes32,bytes,uint64))
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetEncryptedCommitment(commitmentIndex [32]byte) (PreConfCommitmentStoreEncrPreConfCommitment, error) {
+	return _PreConfCommitmentStore.Contract.GetEncryptedCommitment(&_PreConfCommitmentStore.CallOpts, commitmentIndex)
+}
+
+// GetEncryptedCommitmentIndex is a free data retrieval call binding the contract method 0x039d6050.
+//
+// Solidity: function getEncryptedCommitmentIndex((bool,address,bytes32,bytes,uint64) commitment) pure returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetEncryptedCommitmentIndex(opts *bind.CallOpts, commitment PreConfCommitmentStoreEncrPreConfCommitment) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getEncryptedCommitmentIndex", commitment)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetEncryptedCommitmentIndex is a free data retrieval call binding the contract method 0x039d6050.
+//
+// Solidity: function getEncryptedCommitmentIndex((bool,address,bytes32,bytes,uint64) commitment) pure returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetEncryptedCommitmentIndex(commitment PreConfCommitmentStoreEncrPreConfCommitment) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetEncryptedCommitmentIndex(&_PreConfCommitmentStore.CallOpts, commitment)
+}
+
+// GetEncryptedCommitmentIndex is a free data retrieval call binding the contract method 0x039d6050.
+//
+// Solidity: function getEncryptedCommitmentIndex((bool,address,bytes32,bytes,uint64) commitment) pure returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetEncryptedCommitmentIndex(commitment PreConfCommitmentStoreEncrPreConfCommitment) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetEncryptedCommitmentIndex(&_PreConfCommitmentStore.CallOpts, commitment)
+}
+
+// GetPreConfHash is a free data retrieval call binding the contract method 0xef913cf6.
+//
+// Solidity: function getPreConfHash(string _txnHash, uint64 _bid, uint64 _blockNumber, uint64 _decayStartTimeStamp, uint64 _decayEndTimeStamp, bytes32 _bidHash, string _bidSignature, string _sharedSecretKey) view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetPreConfHash(opts *bind.CallOpts, _txnHash string, _bid uint64, _blockNumber uint64, _decayStartTimeStamp uint64, _decayEndTimeStamp uint64, _bidHash [32]byte, _bidSignature string, _sharedSecretKey string) ([32]byte, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getPreConfHash", _txnHash, _bid, _blockNumber, _decayStartTimeStamp, _decayEndTimeStamp, _bidHash, _bidSignature, _sharedSecretKey)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetPreConfHash is a free data retrieval call binding the contract method 0xef913cf6.
+//
+// Solidity: function getPreConfHash(string _txnHash, uint64 _bid, uint64 _blockNumber, uint64 _decayStartTimeStamp, uint64 _decayEndTimeStamp, bytes32 _bidHash, string _bidSignature, string _sharedSecretKey) view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetPreConfHash(_txnHash string, _bid uint64, _blockNumber uint64, _decayStartTimeStamp uint64, _decayEndTimeStamp uint64, _bidHash [32]byte, _bidSignature string, _sharedSecretKey string) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetPreConfHash(&_PreConfCommitmentStore.CallOpts, _txnHash, _bid, _blockNumber, _decayStartTimeStamp, _decayEndTimeStamp, _bidHash, _bidSignature, _sharedSecretKey)
+}
+
+// GetPreConfHash is a free data retrieval call binding the contract method 0xef913cf6.
+//
+// Solidity: function getPreConfHash(string _txnHash, uint64 _bid, uint64 _blockNumber, uint64 _decayStartTimeStamp, uint64 _decayEndTimeStamp, bytes32 _bidHash, string _bidSignature, string _sharedSecretKey) view returns(bytes32)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetPreConfHash(_txnHash string, _bid uint64, _blockNumber uint64, _decayStartTimeStamp uint64, _decayEndTimeStamp uint64, _bidHash [32]byte, _bidSignature string, _sharedSecretKey string) ([32]byte, error) {
+	return _PreConfCommitmentStore.Contract.GetPreConfHash(&_PreConfCommitmentStore.CallOpts, _txnHash, _bid, _blockNumber, _decayStartTimeStamp, _decayEndTimeStamp, _bidHash, _bidSignature, _sharedSecretKey)
+}
+
+// GetTxnHashFromCommitment is a free data retrieval call binding the contract method 0xfc4fbe32.
+//
+// Solidity: function getTxnHashFromCommitment(bytes32 commitmentIndex) view returns(string txnHash)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) GetTxnHashFromCommitment(opts *bind.CallOpts, commitmentIndex [32]byte) (string, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "getTxnHashFromCommitment", commitmentIndex)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetTxnHashFromCommitment is a free data retrieval call binding the contract method 0xfc4fbe32.
+//
+// Solidity: function getTxnHashFromCommitment(bytes32 commitmentIndex) view returns(string txnHash)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) GetTxnHashFromCommitment(commitmentIndex [32]byte) (string, error) {
+	return _PreConfCommitmentStore.Contract.GetTxnHashFromCommitment(&_PreConfCommitmentStore.CallOpts, commitmentIndex)
+}
+
+// GetTxnHashFromCommitment is a free data retrieval call binding the contract method 0xfc4fbe32.
+//
+// Solidity: function getTxnHashFromCommitment(bytes32 commitmentIndex) view returns(string txnHash)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) GetTxnHashFromCommitment(commitmentIndex [32]byte) (string, error) {
+	return _PreConfCommitmentStore.Contract.GetTxnHashFromCommitment(&_PreConfCommitmentStore.CallOpts, commitmentIndex)
+}
+
+// LastProcessedBlock is a free data retrieval call binding the contract method 0x33de61d2.
+//
+// Solidity: function lastProcessedBlock() view returns(uint256)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) LastProcessedBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "lastProcessedBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastProcessedBlock is a free data retrieval call binding the contract method 0x33de61d2.
+//
+// Solidity: function lastProcessedBlock() view returns(uint256)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) LastProcessedBlock() (*big.Int, error) {
+	return _PreConfCommitmentStore.Contract.LastProcessedBlock(&_PreConfCommitmentStore.CallOpts)
+}
+
+// LastProcessedBlock is a free data retrieval call binding the contract method 0x33de61d2.
+//
+// Solidity: function lastProcessedBlock() view returns(uint256)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) LastProcessedBlock() (*big.Int, error) {
+	return _PreConfCommitmentStore.Contract.LastProcessedBlock(&_PreConfCommitmentStore.CallOpts)
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) Oracle(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "oracle")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) Oracle() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.Oracle(&_PreConfCommitmentStore.CallOpts)
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) Oracle() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.Oracle(&_PreConfCommitmentStore.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) Owner() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.Owner(&_PreConfCommitmentStore.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) Owner() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.Owner(&_PreConfCommitmentStore.CallOpts)
+}
+
+// ProviderRegistry is a free data retrieval call binding the contract method 0x545921d9.
+//
+// Solidity: function providerRegistry() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) ProviderRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "providerRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ProviderRegistry is a free data retrieval call binding the contract method 0x545921d9.
+//
+// Solidity: function providerRegistry() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) ProviderRegistry() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.ProviderRegistry(&_PreConfCommitmentStore.CallOpts)
+}
+
+// ProviderRegistry is a free data retrieval call binding the contract method 0x545921d9.
+//
+// Solidity: function providerRegistry() view returns(address)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) ProviderRegistry() (common.Address, error) {
+	return _PreConfCommitmentStore.Contract.ProviderRegistry(&_PreConfCommitmentStore.CallOpts)
+}
+
+// VerifyBid is a free data retrieval call binding the contract method 0x45216cb6.
+//
+// Solidity: function verifyBid(uint64 bid, uint64 blockNumber, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes bidSignature) view returns(bytes32 messageDigest, address recoveredAddress)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) VerifyBid(opts *bind.CallOpts, bid uint64, blockNumber uint64, decayStartTimeStamp uint64, decayEndTimeStamp uint64, txnHash string, bidSignature []byte) (struct {
+	MessageDigest    [32]byte
+	RecoveredAddress common.Address
+}, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "verifyBid", bid, blockNumber, decayStartTimeStamp, decayEndTimeStamp, txnHash, bidSignature)
+
+	outstruct := new(struct {
+		MessageDigest    [32]byte
+		RecoveredAddress common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.MessageDigest = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.RecoveredAddress = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// VerifyBid is a free data retrieval call binding the contract method 0x45216cb6.
+//
+// Solidity: function verifyBid(uint64 bid, uint64 blockNumber, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes bidSignature) view returns(bytes32 messageDigest, address recoveredAddress)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) VerifyBid(bid uint64, blockNumber uint64, decayStartTimeStamp uint64, decayEndTimeStamp uint64, txnHash string, bidSignature []byte) (struct {
+	MessageDigest    [32]byte
+	RecoveredAddress common.Address
+}, error) {
+	return _PreConfCommitmentStore.Contract.VerifyBid(&_PreConfCommitmentStore.CallOpts, bid, blockNumber, decayStartTimeStamp, decayEndTimeStamp, txnHash, bidSignature)
+}
+
+// VerifyBid is a free data retrieval call binding the contract method 0x45216cb6.
+//
+// Solidity: function verifyBid(uint64 bid, uint64 blockNumber, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes bidSignature) view returns(bytes32 messageDigest, address recoveredAddress)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) VerifyBid(bid uint64, blockNumber uint64, decayStartTimeStamp uint64, decayEndTimeStamp uint64, txnHash string, bidSignature []byte) (struct {
+	MessageDigest    [32]byte
+	RecoveredAddress common.Address
+}, error) {
+	return _PreConfCommitmentStore.Contract.VerifyBid(&_PreConfCommitmentStore.CallOpts, bid, blockNumber, decayStartTimeStamp, decayEndTimeStamp, txnHash, bidSignature)
+}
+
+// VerifyPreConfCommitment is a free data retrieval call binding the contract method 0xecf10eca.
+//
+// Solidity: function verifyPreConfCommitment(string txnHash, uint64 bid, uint64 blockNumber, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, bytes32 bidHash, bytes bidSignature, bytes commitmentSignature, bytes sharedSecretKey) view returns(bytes32 preConfHash, address commiterAddress)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCaller) VerifyPreConfCommitment(opts *bind.CallOpts, txnHash string, bid uint64, blockNumber uint64, decayStartTimeStamp uint64, decayEndTimeStamp uint64, bidHash [32]byte, bidSignature []byte, commitmentSignature []byte, sharedSecretKey []byte) (struct {
+	PreConfHash     [32]byte
+	CommiterAddress common.Address
+}, error) {
+	var out []interface{}
+	err := _PreConfCommitmentStore.contract.Call(opts, &out, "verifyPreConfCommitment", txnHash, bid, blockNumber, decayStartTimeStamp, decayEndTimeStamp, bidHash, bidSignature, commitmentSignature, sharedSecretKey)
+
+	outstruct := new(struct {
+		PreConfHash     [32]byte
+		CommiterAddress common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.PreConfHash = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.CommiterAddress = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// VerifyPreConfCommitment is a free data retrieval call binding the contract method 0xecf10eca.
+//
+// Solidity: function verifyPreConfCommitment(string txnHash, uint64 bid, uint64 blockNumber, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, bytes32 bidHash, bytes bidSignature, bytes commitmentSignature, bytes sharedSecretKey) view returns(bytes32 preConfHash, address commiterAddress)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) VerifyPreConfCommitment(txnHash string, bid uint64, blockNumber uint64, decayStartTimeStamp uint64, decayEndTimeStamp uint64, bidHash [32]byte, bidSignature []byte, commitmentSignature []byte, sharedSecretKey []byte) (struct {
+	PreConfHash     [32]byte
+	CommiterAddress common.Address
+}, error) {
+	return _PreConfCommitmentStore.Contract.VerifyPreConfCommitment(&_PreConfCommitmentStore.CallOpts, txnHash, bid, blockNumber, decayStartTimeStamp, decayEndTimeStamp, bidHash, bidSignature, commitmentSignature, sharedSecretKey)
+}
+
+// VerifyPreConfCommitment is a free data retrieval call binding the contract method 0xecf10eca.
+//
+// Solidity: function verifyPreConfCommitment(string txnHash, uint64 bid, uint64 blockNumber, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, bytes32 bidHash, bytes bidSignature, bytes commitmentSignature, bytes sharedSecretKey) view returns(bytes32 preConfHash, address commiterAddress)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreCallerSession) VerifyPreConfCommitment(txnHash string, bid uint64, blockNumber uint64, decayStartTimeStamp uint64, decayEndTimeStamp uint64, bidHash [32]byte, bidSignature []byte, commitmentSignature []byte, sharedSecretKey []byte) (struct {
+	PreConfHash     [32]byte
+	CommiterAddress common.Address
+}, error) {
+	return _PreConfCommitmentStore.Contract.VerifyPreConfCommitment(&_PreConfCommitmentStore.CallOpts, txnHash, bid, blockNumber, decayStartTimeStamp, decayEndTimeStamp, bidHash, bidSignature, commitmentSignature, sharedSecretKey)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x5a606b41.
+//
+// Solidity: function initialize(address _providerRegistry, address _bidderRegistry, address _oracle, address _owner, address _blockTracker, uint64 _commitmentDispatchWindow) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) Initialize(opts *bind.TransactOpts, _providerRegistry common.Address, _bidderRegistry common.Address, _oracle common.Address, _owner common.Address, _blockTracker common.Address, _commitmentDispatchWindow uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "initialize", _providerRegistry, _bidderRegistry, _oracle, _owner, _blockTracker, _commitmentDispatchWindow)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x5a606b41.
+//
+// Solidity: function initialize(address _providerRegistry, address _bidderRegistry, address _oracle, address _owner, address _blockTracker, uint64 _commitmentDispatchWindow) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) Initialize(_providerRegistry common.Address, _bidderRegistry common.Address, _oracle common.Address, _owner common.Address, _blockTracker common.Address, _commitmentDispatchWindow uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.Initialize(&_PreConfCommitmentStore.TransactOpts, _providerRegistry, _bidderRegistry, _oracle, _owner, _blockTracker, _commitmentDispatchWindow)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x5a606b41.
+//
+// Solidity: function initialize(address _providerRegistry, address _bidderRegistry, address _oracle, address _owner, address _blockTracker, uint64 _commitmentDispatchWindow) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) Initialize(_providerRegistry common.Address, _bidderRegistry common.Address, _oracle common.Address, _owner common.Address, _blockTracker common.Address, _commitmentDispatchWindow uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.Initialize(&_PreConfCommitmentStore.TransactOpts, _providerRegistry, _bidderRegistry, _oracle, _owner, _blockTracker, _commitmentDispatchWindow)
+}
+
+// InitiateReward is a paid mutator transaction binding the contract method 0x03faf979.
+//
+// Solidity: function initiateReward(bytes32 commitmentIndex, uint256 residualBidPercentAfterDecay) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) InitiateReward(opts *bind.TransactOpts, commitmentIndex [32]byte, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "initiateReward", commitmentIndex, residualBidPercentAfterDecay)
+}
+
+// InitiateReward is a paid mutator transaction binding the contract method 0x03faf979.
+//
+// Solidity: function initiateReward(bytes32 commitmentIndex, uint256 residualBidPercentAfterDecay) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) InitiateReward(commitmentIndex [32]byte, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.InitiateReward(&_PreConfCommitmentStore.TransactOpts, commitmentIndex, residualBidPercentAfterDecay)
+}
+
+// InitiateReward is a paid mutator transaction binding the contract method 0x03faf979.
+//
+// Solidity: function initiateReward(bytes32 commitmentIndex, uint256 residualBidPercentAfterDecay) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) InitiateReward(commitmentIndex [32]byte, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.InitiateReward(&_PreConfCommitmentStore.TransactOpts, commitmentIndex, residualBidPercentAfterDecay)
+}
+
+// InitiateSlash is a paid mutator transaction binding the contract method 0x30778c78.
+//
+// Solidity: function initiateSlash(bytes32 commitmentIndex, uint256 residualBidPercentAfterDecay) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) InitiateSlash(opts *bind.TransactOpts, commitmentIndex [32]byte, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "initiateSlash", commitmentIndex, residualBidPercentAfterDecay)
+}
+
+// InitiateSlash is a paid mutator transaction binding the contract method 0x30778c78.
+//
+// Solidity: function initiateSlash(bytes32 commitmentIndex, uint256 residualBidPercentAfterDecay) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) InitiateSlash(commitmentIndex [32]byte, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.InitiateSlash(&_PreConfCommitmentStore.TransactOpts, commitmentIndex, residualBidPercentAfterDecay)
+}
+
+// InitiateSlash is a paid mutator transaction binding the contract method 0x30778c78.
+//
+// Solidity: function initiateSlash(bytes32 commitmentIndex, uint256 residualBidPercentAfterDecay) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) InitiateSlash(commitmentIndex [32]byte, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.InitiateSlash(&_PreConfCommitmentStore.TransactOpts, commitmentIndex, residualBidPercentAfterDecay)
+}
+
+// OpenCommitment is a paid mutator transaction binding the contract method 0xf1bb40ef.
+//
+// Solidity: function openCommitment(bytes32 encryptedCommitmentIndex, uint64 bid, uint64 blockNumber, string txnHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, bytes bidSignature, bytes commitmentSignature, bytes sharedSecretKey) returns(bytes32 commitmentIndex)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) OpenCommitment(opts *bind.TransactOpts, encryptedCommitmentIndex [32]byte, bid uint64, blockNumber uint64, txnHash string, decayStartTimeStamp uint64, decayEndTimeStamp uint64, bidSignature []byte, commitmentSignature []byte, sharedSecretKey []byte) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "openCommitment", encryptedCommitmentIndex, bid, blockNumber, txnHash, decayStartTimeStamp, decayEndTimeStamp, bidSignature, commitmentSignature, sharedSecretKey)
+}
+
+// OpenCommitment is a paid mutator transaction binding the contract method 0xf1bb40ef.
+//
+// Solidity: function openCommitment(bytes32 encryptedCommitmentIndex, uint64 bid, uint64 blockNumber, string txnHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, bytes bidSignature, bytes commitmentSignature, bytes sharedSecretKey) returns(bytes32 commitmentIndex)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) OpenCommitment(encryptedCommitmentIndex [32]byte, bid uint64, blockNumber uint64, txnHash string, decayStartTimeStamp uint64, decayEndTimeStamp uint64, bidSignature []byte, commitmentSignature []byte, sharedSecretKey []byte) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.OpenCommitment(&_PreConfCommitmentStore.TransactOpts, encryptedCommitmentIndex, bid, blockNumber, txnHash, decayStartTimeStamp, decayEndTimeStamp, bidSignature, commitmentSignature, sharedSecretKey)
+}
+
+// OpenCommitment is a paid mutator transaction binding the contract method 0xf1bb40ef.
+//
+// Solidity: function openCommitment(bytes32 encryptedCommitmentIndex, uint64 bid, uint64 blockNumber, string txnHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, bytes bidSignature, bytes commitmentSignature, bytes sharedSecretKey) returns(bytes32 commitmentIndex)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) OpenCommitment(encryptedCommitmentIndex [32]byte, bid uint64, blockNumber uint64, txnHash string, decayStartTimeStamp uint64, decayEndTimeStamp uint64, bidSignature []byte, commitmentSignature []byte, sharedSecretKey []byte) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.OpenCommitment(&_PreConfCommitmentStore.TransactOpts, encryptedCommitmentIndex, bid, blockNumber, txnHash, decayStartTimeStamp, decayEndTimeStamp, bidSignature, commitmentSignature, sharedSecretKey)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) RenounceOwnership() (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.RenounceOwnership(&_PreConfCommitmentStore.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.RenounceOwnership(&_PreConfCommitmentStore.TransactOpts)
+}
+
+// StoreEncryptedCommitment is a paid mutator transaction binding the contract method 0x88b74730.
+//
+// Solidity: function storeEncryptedCommitment(bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp) returns(bytes32 commitmentIndex)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) StoreEncryptedCommitment(opts *bind.TransactOpts, commitmentDigest [32]byte, commitmentSignature []byte, dispatchTimestamp uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "storeEncryptedCommitment", commitmentDigest, commitmentSignature, dispatchTimestamp)
+}
+
+// StoreEncryptedCommitment is a paid mutator transaction binding the contract method 0x88b74730.
+//
+// Solidity: function storeEncryptedCommitment(bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp) returns(bytes32 commitmentIndex)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) StoreEncryptedCommitment(commitmentDigest [32]byte, commitmentSignature []byte, dispatchTimestamp uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.StoreEncryptedCommitment(&_PreConfCommitmentStore.TransactOpts, commitmentDigest, commitmentSignature, dispatchTimestamp)
+}
+
+// StoreEncryptedCommitment is a paid mutator transaction binding the contract method 0x88b74730.
+//
+// Solidity: function storeEncryptedCommitment(bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp) returns(bytes32 commitmentIndex)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) StoreEncryptedCommitment(commitmentDigest [32]byte, commitmentSignature []byte, dispatchTimestamp uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.StoreEncryptedCommitment(&_PreConfCommitmentStore.TransactOpts, commitmentDigest, commitmentSignature, dispatchTimestamp)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.TransferOwnership(&_PreConfCommitmentStore.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.TransferOwnership(&_PreConfCommitmentStore.TransactOpts, newOwner)
+}
+
+// UpdateBidderRegistry is a paid mutator transaction binding the contract method 0x66544c41.
+//
+// Solidity: function updateBidderRegistry(address newBidderRegistry) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) UpdateBidderRegistry(opts *bind.TransactOpts, newBidderRegistry common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "updateBidderRegistry", newBidderRegistry)
+}
+
+// UpdateBidderRegistry is a paid mutator transaction binding the contract method 0x66544c41.
+//
+// Solidity: function updateBidderRegistry(address newBidderRegistry) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) UpdateBidderRegistry(newBidderRegistry common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateBidderRegistry(&_PreConfCommitmentStore.TransactOpts, newBidderRegistry)
+}
+
+// UpdateBidderRegistry is a paid mutator transaction binding the contract method 0x66544c41.
+//
+// Solidity: function updateBidderRegistry(address newBidderRegistry) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) UpdateBidderRegistry(newBidderRegistry common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateBidderRegistry(&_PreConfCommitmentStore.TransactOpts, newBidderRegistry)
+}
+
+// UpdateCommitmentDispatchWindow is a paid mutator transaction binding the contract method 0x03800560.
+//
+// Solidity: function updateCommitmentDispatchWindow(uint64 newDispatchWindow) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) UpdateCommitmentDispatchWindow(opts *bind.TransactOpts, newDispatchWindow uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "updateCommitmentDispatchWindow", newDispatchWindow)
+}
+
+// UpdateCommitmentDispatchWindow is a paid mutator transaction binding the contract method 0x03800560.
+//
+// Solidity: function updateCommitmentDispatchWindow(uint64 newDispatchWindow) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) UpdateCommitmentDispatchWindow(newDispatchWindow uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateCommitmentDispatchWindow(&_PreConfCommitmentStore.TransactOpts, newDispatchWindow)
+}
+
+// UpdateCommitmentDispatchWindow is a paid mutator transaction binding the contract method 0x03800560.
+//
+// Solidity: function updateCommitmentDispatchWindow(uint64 newDispatchWindow) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) UpdateCommitmentDispatchWindow(newDispatchWindow uint64) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateCommitmentDispatchWindow(&_PreConfCommitmentStore.TransactOpts, newDispatchWindow)
+}
+
+// UpdateOracle is a paid mutator transaction binding the contract method 0x1cb44dfc.
+//
+// Solidity: function updateOracle(address newOracle) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) UpdateOracle(opts *bind.TransactOpts, newOracle common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "updateOracle", newOracle)
+}
+
+// UpdateOracle is a paid mutator transaction binding the contract method 0x1cb44dfc.
+//
+// Solidity: function updateOracle(address newOracle) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) UpdateOracle(newOracle common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateOracle(&_PreConfCommitmentStore.TransactOpts, newOracle)
+}
+
+// UpdateOracle is a paid mutator transaction binding the contract method 0x1cb44dfc.
+//
+// Solidity: function updateOracle(address newOracle) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) UpdateOracle(newOracle common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateOracle(&_PreConfCommitmentStore.TransactOpts, newOracle)
+}
+
+// UpdateProviderRegistry is a paid mutator transaction binding the contract method 0x92d2e3e7.
+//
+// Solidity: function updateProviderRegistry(address newProviderRegistry) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) UpdateProviderRegistry(opts *bind.TransactOpts, newProviderRegistry common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.Transact(opts, "updateProviderRegistry", newProviderRegistry)
+}
+
+// UpdateProviderRegistry is a paid mutator transaction binding the contract method 0x92d2e3e7.
+//
+// Solidity: function updateProviderRegistry(address newProviderRegistry) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) UpdateProviderRegistry(newProviderRegistry common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateProviderRegistry(&_PreConfCommitmentStore.TransactOpts, newProviderRegistry)
+}
+
+// UpdateProviderRegistry is a paid mutator transaction binding the contract method 0x92d2e3e7.
+//
+// Solidity: function updateProviderRegistry(address newProviderRegistry) returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) UpdateProviderRegistry(newProviderRegistry common.Address) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.UpdateProviderRegistry(&_PreConfCommitmentStore.TransactOpts, newProviderRegistry)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.Fallback(&_PreConfCommitmentStore.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.Fallback(&_PreConfCommitmentStore.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PreConfCommitmentStore.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreSession) Receive() (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.Receive(&_PreConfCommitmentStore.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_PreConfCommitmentStore *PreConfCommitmentStoreTransactorSession) Receive() (*types.Transaction, error) {
+	return _PreConfCommitmentStore.Contract.Receive(&_PreConfCommitmentStore.TransactOpts)
+}
+
+// PreConfCommitmentStoreCommitmentStoredIterator is returned from FilterCommitmentStored and is used to iterate over the raw logs and unpacked data for CommitmentStored events raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreCommitmentStoredIterator struct {
+	Event *PreConfCommitmentStoreCommitmentStored // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PreConfCommitmentStoreCommitmentStoredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PreConfCommitmentStoreCommitmentStored)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PreConfCommitmentStoreCommitmentStored)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PreConfCommitmentStoreCommitmentStoredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PreConfCommitmentStoreCommitmentStoredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PreConfCommitmentStoreCommitmentStored represents a CommitmentStored event raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreCommitmentStored struct {
+	CommitmentIndex     [32]byte
+	Bidder              common.Address
+	Commiter            common.Address
+	Bid                 uint64
+	BlockNumber         uint64
+	BidHash             [32]byte
+	DecayStartTimeStamp uint64
+	DecayEndTimeStamp   uint64
+	TxnHash             string
+	CommitmentHash      [32]byte
+	BidSignature        []byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+	SharedSecretKey     []byte
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterCommitmentStored is a free log retrieval operation binding the contract event 0xa4aab50afc443b845214b8f4e2e7c32ea42be39a84e532be779802c54ff8ffda.
+//
+// Solidity: event CommitmentStored(bytes32 indexed commitmentIndex, address bidder, address commiter, uint64 bid, uint64 blockNumber, bytes32 bidHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes32 commitmentHash, bytes bidSignature, bytes commitmentSignature, uint64 dispatchTimestamp, bytes sharedSecretKey)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) FilterCommitmentStored(opts *bind.FilterOpts, commitmentIndex [][32]byte) (*PreConfCommitmentStoreCommitmentStoredIterator, error) {
+
+	var commitmentIndexRule []interface{}
+	for _, commitmentIndexItem := range commitmentIndex {
+		commitmentIndexRule = append(commitmentIndexRule, commitmentIndexItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.FilterLogs(opts, "CommitmentStored", commitmentIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreCommitmentStoredIterator{contract: _PreConfCommitmentStore.contract, event: "CommitmentStored", logs: logs, sub: sub}, nil
+}
+
+// WatchCommitmentStored is a free log subscription operation binding the contract event 0xa4aab50afc443b845214b8f4e2e7c32ea42be39a84e532be779802c54ff8ffda.
+//
+// Solidity: event CommitmentStored(bytes32 indexed commitmentIndex, address bidder, address commiter, uint64 bid, uint64 blockNumber, bytes32 bidHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes32 commitmentHash, bytes bidSignature, bytes commitmentSignature, uint64 dispatchTimestamp, bytes sharedSecretKey)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) WatchCommitmentStored(opts *bind.WatchOpts, sink chan<- *PreConfCommitmentStoreCommitmentStored, commitmentIndex [][32]byte) (event.Subscription, error) {
+
+	var commitmentIndexRule []interface{}
+	for _, commitmentIndexItem := range commitmentIndex {
+		commitmentIndexRule = append(commitmentIndexRule, commitmentIndexItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.WatchLogs(opts, "CommitmentStored", commitmentIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PreConfCommitmentStoreCommitmentStored)
+				if err := _PreConfCommitmentStore.contract.UnpackLog(event, "CommitmentStored", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCommitmentStored is a log parse operation binding the contract event 0xa4aab50afc443b845214b8f4e2e7c32ea42be39a84e532be779802c54ff8ffda.
+//
+// Solidity: event CommitmentStored(bytes32 indexed commitmentIndex, address bidder, address commiter, uint64 bid, uint64 blockNumber, bytes32 bidHash, uint64 decayStartTimeStamp, uint64 decayEndTimeStamp, string txnHash, bytes32 commitmentHash, bytes bidSignature, bytes commitmentSignature, uint64 dispatchTimestamp, bytes sharedSecretKey)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) ParseCommitmentStored(log types.Log) (*PreConfCommitmentStoreCommitmentStored, error) {
+	event := new(PreConfCommitmentStoreCommitmentStored)
+	if err := _PreConfCommitmentStore.contract.UnpackLog(event, "CommitmentStored", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PreConfCommitmentStoreEncryptedCommitmentStoredIterator is returned from FilterEncryptedCommitmentStored and is used to iterate over the raw logs and unpacked data for EncryptedCommitmentStored events raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreEncryptedCommitmentStoredIterator struct {
+	Event *PreConfCommitmentStoreEncryptedCommitmentStored // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PreConfCommitmentStoreEncryptedCommitmentStoredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PreConfCommitmentStoreEncryptedCommitmentStored)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PreConfCommitmentStoreEncryptedCommitmentStored)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PreConfCommitmentStoreEncryptedCommitmentStoredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PreConfCommitmentStoreEncryptedCommitmentStoredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PreConfCommitmentStoreEncryptedCommitmentStored represents a EncryptedCommitmentStored event raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreEncryptedCommitmentStored struct {
+	CommitmentIndex     [32]byte
+	Commiter            common.Address
+	CommitmentDigest    [32]byte
+	CommitmentSignature []byte
+	DispatchTimestamp   uint64
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterEncryptedCommitmentStored is a free log retrieval operation binding the contract event 0x3b9ebc34b9c72a41ddaf26db939c901a27a144dfbebbe80c3b105a7684a617f2.
+//
+// Solidity: event EncryptedCommitmentStored(bytes32 indexed commitmentIndex, address commiter, bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) FilterEncryptedCommitmentStored(opts *bind.FilterOpts, commitmentIndex [][32]byte) (*PreConfCommitmentStoreEncryptedCommitmentStoredIterator, error) {
+
+	var commitmentIndexRule []interface{}
+	for _, commitmentIndexItem := range commitmentIndex {
+		commitmentIndexRule = append(commitmentIndexRule, commitmentIndexItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.FilterLogs(opts, "EncryptedCommitmentStored", commitmentIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreEncryptedCommitmentStoredIterator{contract: _PreConfCommitmentStore.contract, event: "EncryptedCommitmentStored", logs: logs, sub: sub}, nil
+}
+
+// WatchEncryptedCommitmentStored is a free log subscription operation binding the contract event 0x3b9ebc34b9c72a41ddaf26db939c901a27a144dfbebbe80c3b105a7684a617f2.
+//
+// Solidity: event EncryptedCommitmentStored(bytes32 indexed commitmentIndex, address commiter, bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) WatchEncryptedCommitmentStored(opts *bind.WatchOpts, sink chan<- *PreConfCommitmentStoreEncryptedCommitmentStored, commitmentIndex [][32]byte) (event.Subscription, error) {
+
+	var commitmentIndexRule []interface{}
+	for _, commitmentIndexItem := range commitmentIndex {
+		commitmentIndexRule = append(commitmentIndexRule, commitmentIndexItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.WatchLogs(opts, "EncryptedCommitmentStored", commitmentIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PreConfCommitmentStoreEncryptedCommitmentStored)
+				if err := _PreConfCommitmentStore.contract.UnpackLog(event, "EncryptedCommitmentStored", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEncryptedCommitmentStored is a log parse operation binding the contract event 0x3b9ebc34b9c72a41ddaf26db939c901a27a144dfbebbe80c3b105a7684a617f2.
+//
+// Solidity: event EncryptedCommitmentStored(bytes32 indexed commitmentIndex, address commiter, bytes32 commitmentDigest, bytes commitmentSignature, uint64 dispatchTimestamp)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) ParseEncryptedCommitmentStored(log types.Log) (*PreConfCommitmentStoreEncryptedCommitmentStored, error) {
+	event := new(PreConfCommitmentStoreEncryptedCommitmentStored)
+	if err := _PreConfCommitmentStore.contract.UnpackLog(event, "EncryptedCommitmentStored", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PreConfCommitmentStoreInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreInitializedIterator struct {
+	Event *PreConfCommitmentStoreInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PreConfCommitmentStoreInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PreConfCommitmentStoreInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PreConfCommitmentStoreInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PreConfCommitmentStoreInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PreConfCommitmentStoreInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PreConfCommitmentStoreInitialized represents a Initialized event raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) FilterInitialized(opts *bind.FilterOpts) (*PreConfCommitmentStoreInitializedIterator, error) {
+
+	logs, sub, err := _PreConfCommitmentStore.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreInitializedIterator{contract: _PreConfCommitmentStore.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *PreConfCommitmentStoreInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _PreConfCommitmentStore.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PreConfCommitmentStoreInitialized)
+				if err := _PreConfCommitmentStore.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) ParseInitialized(log types.Log) (*PreConfCommitmentStoreInitialized, error) {
+	event := new(PreConfCommitmentStoreInitialized)
+	if err := _PreConfCommitmentStore.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PreConfCommitmentStoreOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreOwnershipTransferredIterator struct {
+	Event *PreConfCommitmentStoreOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PreConfCommitmentStoreOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PreConfCommitmentStoreOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PreConfCommitmentStoreOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PreConfCommitmentStoreOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PreConfCommitmentStoreOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PreConfCommitmentStoreOwnershipTransferred represents a OwnershipTransferred event raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*PreConfCommitmentStoreOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreOwnershipTransferredIterator{contract: _PreConfCommitmentStore.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *PreConfCommitmentStoreOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PreConfCommitmentStoreOwnershipTransferred)
+				if err := _PreConfCommitmentStore.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) ParseOwnershipTransferred(log types.Log) (*PreConfCommitmentStoreOwnershipTransferred, error) {
+	event := new(PreConfCommitmentStoreOwnershipTransferred)
+	if err := _PreConfCommitmentStore.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PreConfCommitmentStoreSignatureVerifiedIterator is returned from FilterSignatureVerified and is used to iterate over the raw logs and unpacked data for SignatureVerified events raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreSignatureVerifiedIterator struct {
+	Event *PreConfCommitmentStoreSignatureVerified // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PreConfCommitmentStoreSignatureVerifiedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PreConfCommitmentStoreSignatureVerified)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PreConfCommitmentStoreSignatureVerified)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PreConfCommitmentStoreSignatureVerifiedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PreConfCommitmentStoreSignatureVerifiedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PreConfCommitmentStoreSignatureVerified represents a SignatureVerified event raised by the PreConfCommitmentStore contract.
+type PreConfCommitmentStoreSignatureVerified struct {
+	Signer      common.Address
+	TxnHash     string
+	Bid         uint64
+	BlockNumber uint64
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignatureVerified is a free log retrieval operation binding the contract event 0x48db0394d84b81a6f3cb6c61ea2dceff3cad797a9b889fe499fc051f08969c4d.
+//
+// Solidity: event SignatureVerified(address indexed signer, string txnHash, uint64 indexed bid, uint64 blockNumber)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) FilterSignatureVerified(opts *bind.FilterOpts, signer []common.Address, bid []uint64) (*PreConfCommitmentStoreSignatureVerifiedIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	var bidRule []interface{}
+	for _, bidItem := range bid {
+		bidRule = append(bidRule, bidItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.FilterLogs(opts, "SignatureVerified", signerRule, bidRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PreConfCommitmentStoreSignatureVerifiedIterator{contract: _PreConfCommitmentStore.contract, event: "SignatureVerified", logs: logs, sub: sub}, nil
+}
+
+// WatchSignatureVerified is a free log subscription operation binding the contract event 0x48db0394d84b81a6f3cb6c61ea2dceff3cad797a9b889fe499fc051f08969c4d.
+//
+// Solidity: event SignatureVerified(address indexed signer, string txnHash, uint64 indexed bid, uint64 blockNumber)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) WatchSignatureVerified(opts *bind.WatchOpts, sink chan<- *PreConfCommitmentStoreSignatureVerified, signer []common.Address, bid []uint64) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	var bidRule []interface{}
+	for _, bidItem := range bid {
+		bidRule = append(bidRule, bidItem)
+	}
+
+	logs, sub, err := _PreConfCommitmentStore.contract.WatchLogs(opts, "SignatureVerified", signerRule, bidRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PreConfCommitmentStoreSignatureVerified)
+				if err := _PreConfCommitmentStore.contract.UnpackLog(event, "SignatureVerified", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignatureVerified is a log parse operation binding the contract event 0x48db0394d84b81a6f3cb6c61ea2dceff3cad797a9b889fe499fc051f08969c4d.
+//
+// Solidity: event SignatureVerified(address indexed signer, string txnHash, uint64 indexed bid, uint64 blockNumber)
+func (_PreConfCommitmentStore *PreConfCommitmentStoreFilterer) ParseSignatureVerified(log types.Log) (*PreConfCommitmentStoreSignatureVerified, error) {
+	event := new(PreConfCommitmentStoreSignatureVerified)
+	if err := _PreConfCommitmentStore.contract.UnpackLog(event, "SignatureVerified", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1507 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package providerregistry
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ProviderRegistryMetaData contains all meta data concerning the ProviderRegistry contract.
+var ProviderRegistryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"fallback\",\"stateMutability\":\"payable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"bidderAmount\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"checkStake\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"depositFunds\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"feePercent\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feeRecipient\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feeRecipientAmount\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_minStake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_feePercent\",\"type\":\"uint16\",\"internalType\":\"uint16\"},{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"minStake\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preConfirmationsContract\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"providerRegistered\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"providerStakes\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"registerAndStake\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNewFeePercent\",\"inputs\":[{\"name\":\"newFeePercent\",\"type\":\"uint16\",\"internalType\":\"uint16\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNewFeeRecipient\",\"inputs\":[{\"name\":\"newFeeRecipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPreconfirmationsContract\",\"inputs\":[{\"name\":\"contractAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"slash\",\"inputs\":[{\"name\":\"amt\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"provider\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"addresspayable\"},{\"name\":\"residualBidPercentAfterDecay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawBidderAmount\",\"inputs\":[{\"name\":\"bidder\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawFeeRecipientAmount\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawStakedAmount\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"internalType\":\"addresspayable\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"FundsDeposited\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"FundsSlashed\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ProviderRegistered\",\"inputs\":[{\"name\":\"provider\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"stakedAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]}]",
+}
+
+// ProviderRegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use ProviderRegistryMetaData.ABI instead.
+var ProviderRegistryABI = ProviderRegistryMetaData.ABI
+
+// ProviderRegistry is an auto generated Go binding around an Ethereum contract.
+type ProviderRegistry struct {
+	ProviderRegistryCaller     // Read-only binding to the contract
+	ProviderRegistryTransactor // Write-only binding to the contract
+	ProviderRegistryFilterer   // Log filterer for contract events
+}
+
+// ProviderRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ProviderRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProviderRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ProviderRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProviderRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ProviderRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProviderRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ProviderRegistrySession struct {
+	Contract     *ProviderRegistry // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ProviderRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ProviderRegistryCallerSession struct {
+	Contract *ProviderRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// ProviderRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ProviderRegistryTransactorSession struct {
+	Contract     *ProviderRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// ProviderRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ProviderRegistryRaw struct {
+	Contract *ProviderRegistry // Generic contract binding to access the raw methods on
+}
+
+// ProviderRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ProviderRegistryCallerRaw struct {
+	Contract *ProviderRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ProviderRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ProviderRegistryTransactorRaw struct {
+	Contract *ProviderRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewProviderRegistry creates a new instance of ProviderRegistry, bound to a specific deployed contract.
+func NewProviderRegistry(address common.Address, backend bind.ContractBackend) (*ProviderRegistry, error) {
+	contract, err := bindProviderRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistry{ProviderRegistryCaller: ProviderRegistryCaller{contract: contract}, ProviderRegistryTransactor: ProviderRegistryTransactor{contract: contract}, ProviderRegistryFilterer: ProviderRegistryFilterer{contract: contract}}, nil
+}
+
+// NewProviderRegistryCaller creates a new read-only instance of ProviderRegistry, bound to a specific deployed contract.
+func NewProviderRegistryCaller(address common.Address, caller bind.ContractCaller) (*ProviderRegistryCaller, error) {
+	contract, err := bindProviderRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryCaller{contract: contract}, nil
+}
+
+// NewProviderRegistryTransactor creates a new write-only instance of ProviderRegistry, bound to a specific deployed contract.
+func NewProviderRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*ProviderRegistryTransactor, error) {
+	contract, err := bindProviderRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryTransactor{contract: contract}, nil
+}
+
+// NewProviderRegistryFilterer creates a new log filterer instance of ProviderRegistry, bound to a specific deployed contract.
+func NewProviderRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*ProviderRegistryFilterer, error) {
+	contract, err := bindProviderRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryFilterer{contract: contract}, nil
+}
+
+// bindProviderRegistry binds a generic wrapper to an already deployed contract.
+func bindProviderRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ProviderRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ProviderRegistry *ProviderRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ProviderRegistry.Contract.ProviderRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ProviderRegistry *ProviderRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.ProviderRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ProviderRegistry *ProviderRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.ProviderRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ProviderRegistry *ProviderRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ProviderRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ProviderRegistry *ProviderRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ProviderRegistry *ProviderRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// BidderAmount is a free data retrieval call binding the contract method 0x218a5e67.
+//
+// Solidity: function bidderAmount(address ) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCaller) BidderAmount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "bidderAmount", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BidderAmount is a free data retrieval call binding the contract method 0x218a5e67.
+//
+// Solidity: function bidderAmount(address ) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistrySession) BidderAmount(arg0 common.Address) (*big.Int, error) {
+	return _ProviderRegistry.Contract.BidderAmount(&_ProviderRegistry.CallOpts, arg0)
+}
+
+// BidderAmount is a free data retrieval call binding the contract method 0x218a5e67.
+//
+// Solidity: function bidderAmount(address ) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCallerSession) BidderAmount(arg0 common.Address) (*big.Int, error) {
+	return _ProviderRegistry.Contract.BidderAmount(&_ProviderRegistry.CallOpts, arg0)
+}
+
+// CheckStake is a free data retrieval call binding the contract method 0x90d96d76.
+//
+// Solidity: function checkStake(address provider) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCaller) CheckStake(opts *bind.CallOpts, provider common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "checkStake", provider)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CheckStake is a free data retrieval call binding the contract method 0x90d96d76.
+//
+// Solidity: function checkStake(address provider) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistrySession) CheckStake(provider common.Address) (*big.Int, error) {
+	return _ProviderRegistry.Contract.CheckStake(&_ProviderRegistry.CallOpts, provider)
+}
+
+// CheckStake is a free data retrieval call binding the contract method 0x90d96d76.
+//
+// Solidity: function checkStake(address provider) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCallerSession) CheckStake(provider common.Address) (*big.Int, error) {
+	return _ProviderRegistry.Contract.CheckStake(&_ProviderRegistry.CallOpts, provider)
+}
+
+// FeePercent is a free data retrieval call binding the contract method 0x7fd6f15c.
+//
+// Solidity: function feePercent() view returns(uint16)
+func (_ProviderRegistry *ProviderRegistryCaller) FeePercent(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "feePercent")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// FeePercent is a free data retrieval call binding the contract method 0x7fd6f15c.
+//
+// Solidity: function feePercent() view returns(uint16)
+func (_ProviderRegistry *ProviderRegistrySession) FeePercent() (uint16, error) {
+	return _ProviderRegistry.Contract.FeePercent(&_ProviderRegistry.CallOpts)
+}
+
+// FeePercent is a free data retrieval call binding the contract method 0x7fd6f15c.
+//
+// Solidity: function feePercent() view returns(uint16)
+func (_ProviderRegistry *ProviderRegistryCallerSession) FeePercent() (uint16, error) {
+	return _ProviderRegistry.Contract.FeePercent(&_ProviderRegistry.CallOpts)
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_ProviderRegistry *ProviderRegistryCaller) FeeRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "feeRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_ProviderRegistry *ProviderRegistrySession) FeeRecipient() (common.Address, error) {
+	return _ProviderRegistry.Contract.FeeRecipient(&_ProviderRegistry.CallOpts)
+}
+
+// FeeRecipient is a free data retrieval call binding the contract method 0x46904840.
+//
+// Solidity: function feeRecipient() view returns(address)
+func (_ProviderRegistry *ProviderRegistryCallerSession) FeeRecipient() (common.Address, error) {
+	return _ProviderRegistry.Contract.FeeRecipient(&_ProviderRegistry.CallOpts)
+}
+
+// FeeRecipientAmount is a free data retrieval call binding the contract method 0xe0ae4ebd.
+//
+// Solidity: function feeRecipientAmount() view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCaller) FeeRecipientAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "feeRecipientAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FeeRecipientAmount is a free data retrieval call binding the contract method 0xe0ae4ebd.
+//
+// Solidity: function feeRecipientAmount() view returns(uint256)
+func (_ProviderRegistry *ProviderRegistrySession) FeeRecipientAmount() (*big.Int, error) {
+	return _ProviderRegistry.Contract.FeeRecipientAmount(&_ProviderRegistry.CallOpts)
+}
+
+// FeeRecipientAmount is a free data retrieval call binding the contract method 0xe0ae4ebd.
+//
+// Solidity: function feeRecipientAmount() view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCallerSession) FeeRecipientAmount() (*big.Int, error) {
+	return _ProviderRegistry.Contract.FeeRecipientAmount(&_ProviderRegistry.CallOpts)
+}
+
+// MinStake is a free data retrieval call binding the contract method 0x375b3c0a.
+//
+// Solidity: function minStake() view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCaller) MinStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "minStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinStake is a free data retrieval call binding the contract method 0x375b3c0a.
+//
+// Solidity: function minStake() view returns(uint256)
+func (_ProviderRegistry *ProviderRegistrySession) MinStake() (*big.Int, error) {
+	return _ProviderRegistry.Contract.MinStake(&_ProviderRegistry.CallOpts)
+}
+
+// MinStake is a free data retrieval call binding the contract method 0x375b3c0a.
+//
+// Solidity: function minStake() view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCallerSession) MinStake() (*big.Int, error) {
+	return _ProviderRegistry.Contract.MinStake(&_ProviderRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProviderRegistry *ProviderRegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProviderRegistry *ProviderRegistrySession) Owner() (common.Address, error) {
+	return _ProviderRegistry.Contract.Owner(&_ProviderRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProviderRegistry *ProviderRegistryCallerSession) Owner() (common.Address, error) {
+	return _ProviderRegistry.Contract.Owner(&_ProviderRegistry.CallOpts)
+}
+
+// PreConfirmationsContract is a free data retrieval call binding the contract method 0x0de05a1e.
+//
+// Solidity: function preConfirmationsContract() view returns(address)
+func (_ProviderRegistry *ProviderRegistryCaller) PreConfirmationsContract(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "preConfirmationsContract")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PreConfirmationsContract is a free data retrieval call binding the contract method 0x0de05a1e.
+//
+// Solidity: function preConfirmationsContract() view returns(address)
+func (_ProviderRegistry *ProviderRegistrySession) PreConfirmationsContract() (common.Address, error) {
+	return _ProviderRegistry.Contract.PreConfirmationsContract(&_ProviderRegistry.CallOpts)
+}
+
+// PreConfirmationsContract is a free data retrieval call binding the contract method 0x0de05a1e.
+//
+// Solidity: function preConfirmationsContract() view returns(address)
+func (_ProviderRegistry *ProviderRegistryCallerSession) PreConfirmationsContract() (common.Address, error) {
+	return _ProviderRegistry.Contract.PreConfirmationsContract(&_ProviderRegistry.CallOpts)
+}
+
+// ProviderRegistered is a free data retrieval call binding the contract method 0xab255b41.
+//
+// Solidity: function providerRegistered(address ) view returns(bool)
+func (_ProviderRegistry *ProviderRegistryCaller) ProviderRegistered(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "providerRegistered", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ProviderRegistered is a free data retrieval call binding the contract method 0xab255b41.
+//
+// Solidity: function providerRegistered(address ) view returns(bool)
+func (_ProviderRegistry *ProviderRegistrySession) ProviderRegistered(arg0 common.Address) (bool, error) {
+	return _ProviderRegistry.Contract.ProviderRegistered(&_ProviderRegistry.CallOpts, arg0)
+}
+
+// ProviderRegistered is a free data retrieval call binding the contract method 0xab255b41.
+//
+// Solidity: function providerRegistered(address ) view returns(bool)
+func (_ProviderRegistry *ProviderRegistryCallerSession) ProviderRegistered(arg0 common.Address) (bool, error) {
+	return _ProviderRegistry.Contract.ProviderRegistered(&_ProviderRegistry.CallOpts, arg0)
+}
+
+// ProviderStakes is a free data retrieval call binding the contract method 0x0d6b4c9f.
+//
+// Solidity: function providerStakes(address ) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCaller) ProviderStakes(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ProviderRegistry.contract.Call(opts, &out, "providerStakes", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ProviderStakes is a free data retrieval call binding the contract method 0x0d6b4c9f.
+//
+// Solidity: function providerStakes(address ) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistrySession) ProviderStakes(arg0 common.Address) (*big.Int, error) {
+	return _ProviderRegistry.Contract.ProviderStakes(&_ProviderRegistry.CallOpts, arg0)
+}
+
+// ProviderStakes is a free data retrieval call binding the contract method 0x0d6b4c9f.
+//
+// Solidity: function providerStakes(address ) view returns(uint256)
+func (_ProviderRegistry *ProviderRegistryCallerSession) ProviderStakes(arg0 common.Address) (*big.Int, error) {
+	return _ProviderRegistry.Contract.ProviderStakes(&_ProviderRegistry.CallOpts, arg0)
+}
+
+// DepositFunds is a paid mutator transaction binding the contract method 0xe2c41dbc.
+//
+// Solidity: function depositFunds() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) DepositFunds(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "depositFunds")
+}
+
+// DepositFunds is a paid mutator transaction binding the contract method 0xe2c41dbc.
+//
+// Solidity: function depositFunds() payable returns()
+func (_ProviderRegistry *ProviderRegistrySession) DepositFunds() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.DepositFunds(&_ProviderRegistry.TransactOpts)
+}
+
+// DepositFunds is a paid mutator transaction binding the contract method 0xe2c41dbc.
+//
+// Solidity: function depositFunds() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) DepositFunds() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.DepositFunds(&_ProviderRegistry.TransactOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x66953e62.
+//
+// Solidity: function initialize(uint256 _minStake, address _feeRecipient, uint16 _feePercent, address _owner) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) Initialize(opts *bind.TransactOpts, _minStake *big.Int, _feeRecipient common.Address, _feePercent uint16, _owner common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "initialize", _minStake, _feeRecipient, _feePercent, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x66953e62.
+//
+// Solidity: function initialize(uint256 _minStake, address _feeRecipient, uint16 _feePercent, address _owner) returns()
+func (_ProviderRegistry *ProviderRegistrySession) Initialize(_minStake *big.Int, _feeRecipient common.Address, _feePercent uint16, _owner common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Initialize(&_ProviderRegistry.TransactOpts, _minStake, _feeRecipient, _feePercent, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x66953e62.
+//
+// Solidity: function initialize(uint256 _minStake, address _feeRecipient, uint16 _feePercent, address _owner) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) Initialize(_minStake *big.Int, _feeRecipient common.Address, _feePercent uint16, _owner common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Initialize(&_ProviderRegistry.TransactOpts, _minStake, _feeRecipient, _feePercent, _owner)
+}
+
+// RegisterAndStake is a paid mutator transaction binding the contract method 0x84d180ee.
+//
+// Solidity: function registerAndStake() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) RegisterAndStake(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "registerAndStake")
+}
+
+// RegisterAndStake is a paid mutator transaction binding the contract method 0x84d180ee.
+//
+// Solidity: function registerAndStake() payable returns()
+func (_ProviderRegistry *ProviderRegistrySession) RegisterAndStake() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.RegisterAndStake(&_ProviderRegistry.TransactOpts)
+}
+
+// RegisterAndStake is a paid mutator transaction binding the contract method 0x84d180ee.
+//
+// Solidity: function registerAndStake() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) RegisterAndStake() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.RegisterAndStake(&_ProviderRegistry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProviderRegistry *ProviderRegistrySession) RenounceOwnership() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.RenounceOwnership(&_ProviderRegistry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.RenounceOwnership(&_ProviderRegistry.TransactOpts)
+}
+
+// SetNewFeePercent is a paid mutator transaction binding the contract method 0xfb22febf.
+//
+// Solidity: function setNewFeePercent(uint16 newFeePercent) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) SetNewFeePercent(opts *bind.TransactOpts, newFeePercent uint16) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "setNewFeePercent", newFeePercent)
+}
+
+// SetNewFeePercent is a paid mutator transaction binding the contract method 0xfb22febf.
+//
+// Solidity: function setNewFeePercent(uint16 newFeePercent) returns()
+func (_ProviderRegistry *ProviderRegistrySession) SetNewFeePercent(newFeePercent uint16) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.SetNewFeePercent(&_ProviderRegistry.TransactOpts, newFeePercent)
+}
+
+// SetNewFeePercent is a paid mutator transaction binding the contract method 0xfb22febf.
+//
+// Solidity: function setNewFeePercent(uint16 newFeePercent) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) SetNewFeePercent(newFeePercent uint16) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.SetNewFeePercent(&_ProviderRegistry.TransactOpts, newFeePercent)
+}
+
+// SetNewFeeRecipient is a paid mutator transaction binding the contract method 0xa26652ea.
+//
+// Solidity: function setNewFeeRecipient(address newFeeRecipient) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) SetNewFeeRecipient(opts *bind.TransactOpts, newFeeRecipient common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "setNewFeeRecipient", newFeeRecipient)
+}
+
+// SetNewFeeRecipient is a paid mutator transaction binding the contract method 0xa26652ea.
+//
+// Solidity: function setNewFeeRecipient(address newFeeRecipient) returns()
+func (_ProviderRegistry *ProviderRegistrySession) SetNewFeeRecipient(newFeeRecipient common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.SetNewFeeRecipient(&_ProviderRegistry.TransactOpts, newFeeRecipient)
+}
+
+// SetNewFeeRecipient is a paid mutator transaction binding the contract method 0xa26652ea.
+//
+// Solidity: function setNewFeeRecipient(address newFeeRecipient) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) SetNewFeeRecipient(newFeeRecipient common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.SetNewFeeRecipient(&_ProviderRegistry.TransactOpts, newFeeRecipient)
+}
+
+// SetPreconfirmationsContract is a paid mutator transaction binding the contract method 0xf6c7e476.
+//
+// Solidity: function setPreconfirmationsContract(address contractAddress) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) SetPreconfirmationsContract(opts *bind.TransactOpts, contractAddress common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "setPreconfirmationsContract", contractAddress)
+}
+
+// SetPreconfirmationsContract is a paid mutator transaction binding the contract method 0xf6c7e476.
+//
+// Solidity: function setPreconfirmationsContract(address contractAddress) returns()
+func (_ProviderRegistry *ProviderRegistrySession) SetPreconfirmationsContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.SetPreconfirmationsContract(&_ProviderRegistry.TransactOpts, contractAddress)
+}
+
+// SetPreconfirmationsContract is a paid mutator transaction binding the contract method 0xf6c7e476.
+//
+// Solidity: function setPreconfirmationsContract(address contractAddress) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) SetPreconfirmationsContract(contractAddress common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.SetPreconfirmationsContract(&_ProviderRegistry.TransactOpts, contractAddress)
+}
+
+// Slash is a paid mutator transaction binding the contract method 0x8b6e1f8d.
+//
+// Solidity: function slash(uint256 amt, address provider, address bidder, uint256 residualBidPercentAfterDecay) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) Slash(opts *bind.TransactOpts, amt *big.Int, provider common.Address, bidder common.Address, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "slash", amt, provider, bidder, residualBidPercentAfterDecay)
+}
+
+// Slash is a paid mutator transaction binding the contract method 0x8b6e1f8d.
+//
+// Solidity: function slash(uint256 amt, address provider, address bidder, uint256 residualBidPercentAfterDecay) returns()
+func (_ProviderRegistry *ProviderRegistrySession) Slash(amt *big.Int, provider common.Address, bidder common.Address, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Slash(&_ProviderRegistry.TransactOpts, amt, provider, bidder, residualBidPercentAfterDecay)
+}
+
+// Slash is a paid mutator transaction binding the contract method 0x8b6e1f8d.
+//
+// Solidity: function slash(uint256 amt, address provider, address bidder, uint256 residualBidPercentAfterDecay) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) Slash(amt *big.Int, provider common.Address, bidder common.Address, residualBidPercentAfterDecay *big.Int) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Slash(&_ProviderRegistry.TransactOpts, amt, provider, bidder, residualBidPercentAfterDecay)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProviderRegistry *ProviderRegistrySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.TransferOwnership(&_ProviderRegistry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.TransferOwnership(&_ProviderRegistry.TransactOpts, newOwner)
+}
+
+// WithdrawBidderAmount is a paid mutator transaction binding the contract method 0x292ae408.
+//
+// Solidity: function withdrawBidderAmount(address bidder) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) WithdrawBidderAmount(opts *bind.TransactOpts, bidder common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "withdrawBidderAmount", bidder)
+}
+
+// WithdrawBidderAmount is a paid mutator transaction binding the contract method 0x292ae408.
+//
+// Solidity: function withdrawBidderAmount(address bidder) returns()
+func (_ProviderRegistry *ProviderRegistrySession) WithdrawBidderAmount(bidder common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.WithdrawBidderAmount(&_ProviderRegistry.TransactOpts, bidder)
+}
+
+// WithdrawBidderAmount is a paid mutator transaction binding the contract method 0x292ae408.
+//
+// Solidity: function withdrawBidderAmount(address bidder) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) WithdrawBidderAmount(bidder common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.WithdrawBidderAmount(&_ProviderRegistry.TransactOpts, bidder)
+}
+
+// WithdrawFeeRecipientAmount is a paid mutator transaction binding the contract method 0x7e5713d8.
+//
+// Solidity: function withdrawFeeRecipientAmount() returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) WithdrawFeeRecipientAmount(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "withdrawFeeRecipientAmount")
+}
+
+// WithdrawFeeRecipientAmount is a paid mutator transaction binding the contract method 0x7e5713d8.
+//
+// Solidity: function withdrawFeeRecipientAmount() returns()
+func (_ProviderRegistry *ProviderRegistrySession) WithdrawFeeRecipientAmount() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.WithdrawFeeRecipientAmount(&_ProviderRegistry.TransactOpts)
+}
+
+// WithdrawFeeRecipientAmount is a paid mutator transaction binding the contract method 0x7e5713d8.
+//
+// Solidity: function withdrawFeeRecipientAmount() returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) WithdrawFeeRecipientAmount() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.WithdrawFeeRecipientAmount(&_ProviderRegistry.TransactOpts)
+}
+
+// WithdrawStakedAmount is a paid mutator transaction binding the contract method 0xf31f3da0.
+//
+// Solidity: function withdrawStakedAmount(address provider) returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) WithdrawStakedAmount(opts *bind.TransactOpts, provider common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.Transact(opts, "withdrawStakedAmount", provider)
+}
+
+// WithdrawStakedAmount is a paid mutator transaction binding the contract method 0xf31f3da0.
+//
+// Solidity: function withdrawStakedAmount(address provider) returns()
+func (_ProviderRegistry *ProviderRegistrySession) WithdrawStakedAmount(provider common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.WithdrawStakedAmount(&_ProviderRegistry.TransactOpts, provider)
+}
+
+// WithdrawStakedAmount is a paid mutator transaction binding the contract method 0xf31f3da0.
+//
+// Solidity: function withdrawStakedAmount(address provider) returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) WithdrawStakedAmount(provider common.Address) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.WithdrawStakedAmount(&_ProviderRegistry.TransactOpts, provider)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ProviderRegistry *ProviderRegistrySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Fallback(&_ProviderRegistry.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Fallback(&_ProviderRegistry.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProviderRegistry.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ProviderRegistry *ProviderRegistrySession) Receive() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Receive(&_ProviderRegistry.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ProviderRegistry *ProviderRegistryTransactorSession) Receive() (*types.Transaction, error) {
+	return _ProviderRegistry.Contract.Receive(&_ProviderRegistry.TransactOpts)
+}
+
+// ProviderRegistryFundsDepositedIterator is returned from FilterFundsDeposited and is used to iterate over the raw logs and unpacked data for FundsDeposited events raised by the ProviderRegistry contract.
+type ProviderRegistryFundsDepositedIterator struct {
+	Event *ProviderRegistryFundsDeposited // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProviderRegistryFundsDepositedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProviderRegistryFundsDeposited)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProviderRegistryFundsDeposited)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProviderRegistryFundsDepositedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProviderRegistryFundsDepositedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProviderRegistryFundsDeposited represents a FundsDeposited event raised by the ProviderRegistry contract.
+type ProviderRegistryFundsDeposited struct {
+	Provider common.Address
+	Amount   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterFundsDeposited is a free log retrieval operation binding the contract event 0x543ba50a5eec5e6178218e364b1d0f396157b3c8fa278522c2cb7fd99407d474.
+//
+// Solidity: event FundsDeposited(address indexed provider, uint256 amount)
+func (_ProviderRegistry *ProviderRegistryFilterer) FilterFundsDeposited(opts *bind.FilterOpts, provider []common.Address) (*ProviderRegistryFundsDepositedIterator, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.FilterLogs(opts, "FundsDeposited", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryFundsDepositedIterator{contract: _ProviderRegistry.contract, event: "FundsDeposited", logs: logs, sub: sub}, nil
+}
+
+// WatchFundsDeposited is a free log subscription operation binding the contract event 0x543ba50a5eec5e6178218e364b1d0f396157b3c8fa278522c2cb7fd99407d474.
+//
+// Solidity: event FundsDeposited(address indexed provider, uint256 amount)
+func (_ProviderRegistry *ProviderRegistryFilterer) WatchFundsDeposited(opts *bind.WatchOpts, sink chan<- *ProviderRegistryFundsDeposited, provider []common.Address) (event.Subscription, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.WatchLogs(opts, "FundsDeposited", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProviderRegistryFundsDeposited)
+				if err := _ProviderRegistry.contract.UnpackLog(event, "FundsDeposited", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFundsDeposited is a log parse operation binding the contract event 0x543ba50a5eec5e6178218e364b1d0f396157b3c8fa278522c2cb7fd99407d474.
+//
+// Solidity: event FundsDeposited(address indexed provider, uint256 amount)
+func (_ProviderRegistry *ProviderRegistryFilterer) ParseFundsDeposited(log types.Log) (*ProviderRegistryFundsDeposited, error) {
+	event := new(ProviderRegistryFundsDeposited)
+	if err := _ProviderRegistry.contract.UnpackLog(event, "FundsDeposited", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProviderRegistryFundsSlashedIterator is returned from FilterFundsSlashed and is used to iterate over the raw logs and unpacked data for FundsSlashed events raised by the ProviderRegistry contract.
+type ProviderRegistryFundsSlashedIterator struct {
+	Event *ProviderRegistryFundsSlashed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProviderRegistryFundsSlashedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProviderRegistryFundsSlashed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProviderRegistryFundsSlashed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProviderRegistryFundsSlashedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProviderRegistryFundsSlashedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProviderRegistryFundsSlashed represents a FundsSlashed event raised by the ProviderRegistry contract.
+type ProviderRegistryFundsSlashed struct {
+	Provider common.Address
+	Amount   *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterFundsSlashed is a free log retrieval operation binding the contract event 0x4a00481d3f7b0802643df0bdfb9bfc491a24ffca3eb1becc9fe8b525e0427a74.
+//
+// Solidity: event FundsSlashed(address indexed provider, uint256 amount)
+func (_ProviderRegistry *ProviderRegistryFilterer) FilterFundsSlashed(opts *bind.FilterOpts, provider []common.Address) (*ProviderRegistryFundsSlashedIterator, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.FilterLogs(opts, "FundsSlashed", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryFundsSlashedIterator{contract: _ProviderRegistry.contract, event: "FundsSlashed", logs: logs, sub: sub}, nil
+}
+
+// WatchFundsSlashed is a free log subscription operation binding the contract event 0x4a00481d3f7b0802643df0bdfb9bfc491a24ffca3eb1becc9fe8b525e0427a74.
+//
+// Solidity: event FundsSlashed(address indexed provider, uint256 amount)
+func (_ProviderRegistry *ProviderRegistryFilterer) WatchFundsSlashed(opts *bind.WatchOpts, sink chan<- *ProviderRegistryFundsSlashed, provider []common.Address) (event.Subscription, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.WatchLogs(opts, "FundsSlashed", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProviderRegistryFundsSlashed)
+				if err := _ProviderRegistry.contract.UnpackLog(event, "FundsSlashed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFundsSlashed is a log parse operation binding the contract event 0x4a00481d3f7b0802643df0bdfb9bfc491a24ffca3eb1becc9fe8b525e0427a74.
+//
+// Solidity: event FundsSlashed(address indexed provider, uint256 amount)
+func (_ProviderRegistry *ProviderRegistryFilterer) ParseFundsSlashed(log types.Log) (*ProviderRegistryFundsSlashed, error) {
+	event := new(ProviderRegistryFundsSlashed)
+	if err := _ProviderRegistry.contract.UnpackLog(event, "FundsSlashed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProviderRegistryInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ProviderRegistry contract.
+type ProviderRegistryInitializedIterator struct {
+	Event *ProviderRegistryInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProviderRegistryInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProviderRegistryInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProviderRegistryInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProviderRegistryInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProviderRegistryInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProviderRegistryInitialized represents a Initialized event raised by the ProviderRegistry contract.
+type ProviderRegistryInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ProviderRegistry *ProviderRegistryFilterer) FilterInitialized(opts *bind.FilterOpts) (*ProviderRegistryInitializedIterator, error) {
+
+	logs, sub, err := _ProviderRegistry.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryInitializedIterator{contract: _ProviderRegistry.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ProviderRegistry *ProviderRegistryFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ProviderRegistryInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _ProviderRegistry.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProviderRegistryInitialized)
+				if err := _ProviderRegistry.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ProviderRegistry *ProviderRegistryFilterer) ParseInitialized(log types.Log) (*ProviderRegistryInitialized, error) {
+	event := new(ProviderRegistryInitialized)
+	if err := _ProviderRegistry.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProviderRegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ProviderRegistry contract.
+type ProviderRegistryOwnershipTransferredIterator struct {
+	Event *ProviderRegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProviderRegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProviderRegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProviderRegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProviderRegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProviderRegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProviderRegistryOwnershipTransferred represents a OwnershipTransferred event raised by the ProviderRegistry contract.
+type ProviderRegistryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProviderRegistry *ProviderRegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ProviderRegistryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryOwnershipTransferredIterator{contract: _ProviderRegistry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProviderRegistry *ProviderRegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ProviderRegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProviderRegistryOwnershipTransferred)
+				if err := _ProviderRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProviderRegistry *ProviderRegistryFilterer) ParseOwnershipTransferred(log types.Log) (*ProviderRegistryOwnershipTransferred, error) {
+	event := new(ProviderRegistryOwnershipTransferred)
+	if err := _ProviderRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProviderRegistryProviderRegisteredIterator is returned from FilterProviderRegistered and is used to iterate over the raw logs and unpacked data for ProviderRegistered events raised by the ProviderRegistry contract.
+type ProviderRegistryProviderRegisteredIterator struct {
+	Event *ProviderRegistryProviderRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProviderRegistryProviderRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProviderRegistryProviderRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProviderRegistryProviderRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProviderRegistryProviderRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProviderRegistryProviderRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProviderRegistryProviderRegistered represents a ProviderRegistered event raised by the ProviderRegistry contract.
+type ProviderRegistryProviderRegistered struct {
+	Provider     common.Address
+	StakedAmount *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterProviderRegistered is a free log retrieval operation binding the contract event 0x90c9734131c1e4fb36cde2d71e6feb93fb258f71be8a85411c173d25e1516e80.
+//
+// Solidity: event ProviderRegistered(address indexed provider, uint256 stakedAmount)
+func (_ProviderRegistry *ProviderRegistryFilterer) FilterProviderRegistered(opts *bind.FilterOpts, provider []common.Address) (*ProviderRegistryProviderRegisteredIterator, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.FilterLogs(opts, "ProviderRegistered", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProviderRegistryProviderRegisteredIterator{contract: _ProviderRegistry.contract, event: "ProviderRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchProviderRegistered is a free log subscription operation binding the contract event 0x90c9734131c1e4fb36cde2d71e6feb93fb258f71be8a85411c173d25e1516e80.
+//
+// Solidity: event ProviderRegistered(address indexed provider, uint256 stakedAmount)
+func (_ProviderRegistry *ProviderRegistryFilterer) WatchProviderRegistered(opts *bind.WatchOpts, sink chan<- *ProviderRegistryProviderRegistered, provider []common.Address) (event.Subscription, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _ProviderRegistry.contract.WatchLogs(opts, "ProviderRegistered", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProviderRegistryProviderRegistered)
+				if err := _ProviderRegistry.contract.UnpackLog(event, "ProviderRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProviderRegistered is a log parse operation binding the contract event 0x90c9734131c1e4fb36cde2d71e6feb93fb258f71be8a85411c173d25e1516e80.
+//
+// Solidity: event ProviderRegistered(address indexed provider, uint256 stakedAmount)
+func (_ProviderRegistry *ProviderRegistryFilterer) ParseProviderRegistered(log types.Log) (*ProviderRegistryProviderRegistered, error) {
+	event := new(ProviderRegistryProviderRegistered)
+	if err := _ProviderRegistry.contract.UnpackLog(event, "ProviderRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1111 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package settlementgateway
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SettlementGatewayMetaData contains all meta data concerning the SettlementGateway contract.
+var SettlementGatewayMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"counterpartyFee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finalizationFee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finalizeTransfer\",\"inputs\":[{\"name\":\"_recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_counterpartyIdx\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_whitelistAddr\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_relayer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_finalizationFee\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_counterpartyFee\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initiateTransfer\",\"inputs\":[{\"name\":\"_recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"returnIdx\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"relayer\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferFinalizedIdx\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferInitiatedIdx\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"whitelistAddr\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferFinalized\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"counterpartyIdx\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferInitiated\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"transferIdx\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+}
+
+// SettlementGatewayABI is the input ABI used to generate the binding from.
+// Deprecated: Use SettlementGatewayMetaData.ABI instead.
+var SettlementGatewayABI = SettlementGatewayMetaData.ABI
+
+// SettlementGateway is an auto generated Go binding around an Ethereum contract.
+type SettlementGateway struct {
+	SettlementGatewayCaller     // Read-only binding to the contract
+	SettlementGatewayTransactor // Write-only binding to the contract
+	SettlementGatewayFilterer   // Log filterer for contract events
+}
+
+// SettlementGatewayCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SettlementGatewayCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SettlementGatewayTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SettlementGatewayTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SettlementGatewayFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SettlementGatewayFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SettlementGatewaySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SettlementGatewaySession struct {
+	Contract     *SettlementGateway // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// SettlementGatewayCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SettlementGatewayCallerSession struct {
+	Contract *SettlementGatewayCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// SettlementGatewayTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SettlementGatewayTransactorSession struct {
+	Contract     *SettlementGatewayTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// SettlementGatewayRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SettlementGatewayRaw struct {
+	Contract *SettlementGateway // Generic contract binding to access the raw methods on
+}
+
+// SettlementGatewayCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SettlementGatewayCallerRaw struct {
+	Contract *SettlementGatewayCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SettlementGatewayTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SettlementGatewayTransactorRaw struct {
+	Contract *SettlementGatewayTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSettlementGateway creates a new instance of SettlementGateway, bound to a specific deployed contract.
+func NewSettlementGateway(address common.Address, backend bind.ContractBackend) (*SettlementGateway, error) {
+	contract, err := bindSettlementGateway(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGateway{SettlementGatewayCaller: SettlementGatewayCaller{contract: contract}, SettlementGatewayTransactor: SettlementGatewayTransactor{contract: contract}, SettlementGatewayFilterer: SettlementGatewayFilterer{contract: contract}}, nil
+}
+
+// NewSettlementGatewayCaller creates a new read-only instance of SettlementGateway, bound to a specific deployed contract.
+func NewSettlementGatewayCaller(address common.Address, caller bind.ContractCaller) (*SettlementGatewayCaller, error) {
+	contract, err := bindSettlementGateway(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayCaller{contract: contract}, nil
+}
+
+// NewSettlementGatewayTransactor creates a new write-only instance of SettlementGateway, bound to a specific deployed contract.
+func NewSettlementGatewayTransactor(address common.Address, transactor bind.ContractTransactor) (*SettlementGatewayTransactor, error) {
+	contract, err := bindSettlementGateway(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayTransactor{contract: contract}, nil
+}
+
+// NewSettlementGatewayFilterer creates a new log filterer instance of SettlementGateway, bound to a specific deployed contract.
+func NewSettlementGatewayFilterer(address common.Address, filterer bind.ContractFilterer) (*SettlementGatewayFilterer, error) {
+	contract, err := bindSettlementGateway(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayFilterer{contract: contract}, nil
+}
+
+// bindSettlementGateway binds a generic wrapper to an already deployed contract.
+func bindSettlementGateway(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SettlementGatewayMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SettlementGateway *SettlementGatewayRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SettlementGateway.Contract.SettlementGatewayCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SettlementGateway *SettlementGatewayRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.SettlementGatewayTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SettlementGateway *SettlementGatewayRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.SettlementGatewayTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SettlementGateway *SettlementGatewayCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SettlementGateway.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SettlementGateway *SettlementGatewayTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SettlementGateway *SettlementGatewayTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.contract.Transact(opts, method, params...)
+}
+
+// CounterpartyFee is a free data retrieval call binding the contract method 0x97599011.
+//
+// Solidity: function counterpartyFee() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCaller) CounterpartyFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "counterpartyFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CounterpartyFee is a free data retrieval call binding the contract method 0x97599011.
+//
+// Solidity: function counterpartyFee() view returns(uint256)
+func (_SettlementGateway *SettlementGatewaySession) CounterpartyFee() (*big.Int, error) {
+	return _SettlementGateway.Contract.CounterpartyFee(&_SettlementGateway.CallOpts)
+}
+
+// CounterpartyFee is a free data retrieval call binding the contract method 0x97599011.
+//
+// Solidity: function counterpartyFee() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCallerSession) CounterpartyFee() (*big.Int, error) {
+	return _SettlementGateway.Contract.CounterpartyFee(&_SettlementGateway.CallOpts)
+}
+
+// FinalizationFee is a free data retrieval call binding the contract method 0x78d3d576.
+//
+// Solidity: function finalizationFee() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCaller) FinalizationFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "finalizationFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FinalizationFee is a free data retrieval call binding the contract method 0x78d3d576.
+//
+// Solidity: function finalizationFee() view returns(uint256)
+func (_SettlementGateway *SettlementGatewaySession) FinalizationFee() (*big.Int, error) {
+	return _SettlementGateway.Contract.FinalizationFee(&_SettlementGateway.CallOpts)
+}
+
+// FinalizationFee is a free data retrieval call binding the contract method 0x78d3d576.
+//
+// Solidity: function finalizationFee() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCallerSession) FinalizationFee() (*big.Int, error) {
+	return _SettlementGateway.Contract.FinalizationFee(&_SettlementGateway.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_SettlementGateway *SettlementGatewayCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_SettlementGateway *SettlementGatewaySession) Owner() (common.Address, error) {
+	return _SettlementGateway.Contract.Owner(&_SettlementGateway.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_SettlementGateway *SettlementGatewayCallerSession) Owner() (common.Address, error) {
+	return _SettlementGateway.Contract.Owner(&_SettlementGateway.CallOpts)
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_SettlementGateway *SettlementGatewayCaller) Relayer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "relayer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_SettlementGateway *SettlementGatewaySession) Relayer() (common.Address, error) {
+	return _SettlementGateway.Contract.Relayer(&_SettlementGateway.CallOpts)
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_SettlementGateway *SettlementGatewayCallerSession) Relayer() (common.Address, error) {
+	return _SettlementGateway.Contract.Relayer(&_SettlementGateway.CallOpts)
+}
+
+// TransferFinalizedIdx is a free data retrieval call binding the contract method 0xa2ff158d.
+//
+// Solidity: function transferFinalizedIdx() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCaller) TransferFinalizedIdx(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "transferFinalizedIdx")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferFinalizedIdx is a free data retrieval call binding the contract method 0xa2ff158d.
+//
+// Solidity: function transferFinalizedIdx() view returns(uint256)
+func (_SettlementGateway *SettlementGatewaySession) TransferFinalizedIdx() (*big.Int, error) {
+	return _SettlementGateway.Contract.TransferFinalizedIdx(&_SettlementGateway.CallOpts)
+}
+
+// TransferFinalizedIdx is a free data retrieval call binding the contract method 0xa2ff158d.
+//
+// Solidity: function transferFinalizedIdx() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCallerSession) TransferFinalizedIdx() (*big.Int, error) {
+	return _SettlementGateway.Contract.TransferFinalizedIdx(&_SettlementGateway.CallOpts)
+}
+
+// TransferInitiatedIdx is a free data retrieval call binding the contract method 0xe557b142.
+//
+// Solidity: function transferInitiatedIdx() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCaller) TransferInitiatedIdx(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "transferInitiatedIdx")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferInitiatedIdx is a free data retrieval call binding the contract method 0xe557b142.
+//
+// Solidity: function transferInitiatedIdx() view returns(uint256)
+func (_SettlementGateway *SettlementGatewaySession) TransferInitiatedIdx() (*big.Int, error) {
+	return _SettlementGateway.Contract.TransferInitiatedIdx(&_SettlementGateway.CallOpts)
+}
+
+// TransferInitiatedIdx is a free data retrieval call binding the contract method 0xe557b142.
+//
+// Solidity: function transferInitiatedIdx() view returns(uint256)
+func (_SettlementGateway *SettlementGatewayCallerSession) TransferInitiatedIdx() (*big.Int, error) {
+	return _SettlementGateway.Contract.TransferInitiatedIdx(&_SettlementGateway.CallOpts)
+}
+
+// WhitelistAddr is a free data retrieval call binding the contract method 0x48a0baf8.
+//
+// Solidity: function whitelistAddr() view returns(address)
+func (_SettlementGateway *SettlementGatewayCaller) WhitelistAddr(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SettlementGateway.contract.Call(opts, &out, "whitelistAddr")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WhitelistAddr is a free data retrieval call binding the contract method 0x48a0baf8.
+//
+// Solidity: function whitelistAddr() view returns(address)
+func (_SettlementGateway *SettlementGatewaySession) WhitelistAddr() (common.Address, error) {
+	return _SettlementGateway.Contract.WhitelistAddr(&_SettlementGateway.CallOpts)
+}
+
+// WhitelistAddr is a free data retrieval call binding the contract method 0x48a0baf8.
+//
+// Solidity: function whitelistAddr() view returns(address)
+func (_SettlementGateway *SettlementGatewayCallerSession) WhitelistAddr() (common.Address, error) {
+	return _SettlementGateway.Contract.WhitelistAddr(&_SettlementGateway.CallOpts)
+}
+
+// FinalizeTransfer is a paid mutator transaction binding the contract method 0xc40a7c82.
+//
+// Solidity: function finalizeTransfer(address _recipient, uint256 _amount, uint256 _counterpartyIdx) returns()
+func (_SettlementGateway *SettlementGatewayTransactor) FinalizeTransfer(opts *bind.TransactOpts, _recipient common.Address, _amount *big.Int, _counterpartyIdx *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.contract.Transact(opts, "finalizeTransfer", _recipient, _amount, _counterpartyIdx)
+}
+
+// FinalizeTransfer is a paid mutator transaction binding the contract method 0xc40a7c82.
+//
+// Solidity: function finalizeTransfer(address _recipient, uint256 _amount, uint256 _counterpartyIdx) returns()
+func (_SettlementGateway *SettlementGatewaySession) FinalizeTransfer(_recipient common.Address, _amount *big.Int, _counterpartyIdx *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.FinalizeTransfer(&_SettlementGateway.TransactOpts, _recipient, _amount, _counterpartyIdx)
+}
+
+// FinalizeTransfer is a paid mutator transaction binding the contract method 0xc40a7c82.
+//
+// Solidity: function finalizeTransfer(address _recipient, uint256 _amount, uint256 _counterpartyIdx) returns()
+func (_SettlementGateway *SettlementGatewayTransactorSession) FinalizeTransfer(_recipient common.Address, _amount *big.Int, _counterpartyIdx *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.FinalizeTransfer(&_SettlementGateway.TransactOpts, _recipient, _amount, _counterpartyIdx)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6b63eb8.
+//
+// Solidity: function initialize(address _whitelistAddr, address _owner, address _relayer, uint256 _finalizationFee, uint256 _counterpartyFee) returns()
+func (_SettlementGateway *SettlementGatewayTransactor) Initialize(opts *bind.TransactOpts, _whitelistAddr common.Address, _owner common.Address, _relayer common.Address, _finalizationFee *big.Int, _counterpartyFee *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.contract.Transact(opts, "initialize", _whitelistAddr, _owner, _relayer, _finalizationFee, _counterpartyFee)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6b63eb8.
+//
+// Solidity: function initialize(address _whitelistAddr, address _owner, address _relayer, uint256 _finalizationFee, uint256 _counterpartyFee) returns()
+func (_SettlementGateway *SettlementGatewaySession) Initialize(_whitelistAddr common.Address, _owner common.Address, _relayer common.Address, _finalizationFee *big.Int, _counterpartyFee *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.Initialize(&_SettlementGateway.TransactOpts, _whitelistAddr, _owner, _relayer, _finalizationFee, _counterpartyFee)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6b63eb8.
+//
+// Solidity: function initialize(address _whitelistAddr, address _owner, address _relayer, uint256 _finalizationFee, uint256 _counterpartyFee) returns()
+func (_SettlementGateway *SettlementGatewayTransactorSession) Initialize(_whitelistAddr common.Address, _owner common.Address, _relayer common.Address, _finalizationFee *big.Int, _counterpartyFee *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.Initialize(&_SettlementGateway.TransactOpts, _whitelistAddr, _owner, _relayer, _finalizationFee, _counterpartyFee)
+}
+
+// InitiateTransfer is a paid mutator transaction binding the contract method 0xb504cd1e.
+//
+// Solidity: function initiateTransfer(address _recipient, uint256 _amount) payable returns(uint256 returnIdx)
+func (_SettlementGateway *SettlementGatewayTransactor) InitiateTransfer(opts *bind.TransactOpts, _recipient common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.contract.Transact(opts, "initiateTransfer", _recipient, _amount)
+}
+
+// InitiateTransfer is a paid mutator transaction binding the contract method 0xb504cd1e.
+//
+// Solidity: function initiateTransfer(address _recipient, uint256 _amount) payable returns(uint256 returnIdx)
+func (_SettlementGateway *SettlementGatewaySession) InitiateTransfer(_recipient common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.InitiateTransfer(&_SettlementGateway.TransactOpts, _recipient, _amount)
+}
+
+// InitiateTransfer is a paid mutator transaction binding the contract method 0xb504cd1e.
+//
+// Solidity: function initiateTransfer(address _recipient, uint256 _amount) payable returns(uint256 returnIdx)
+func (_SettlementGateway *SettlementGatewayTransactorSession) InitiateTransfer(_recipient common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.InitiateTransfer(&_SettlementGateway.TransactOpts, _recipient, _amount)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_SettlementGateway *SettlementGatewayTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SettlementGateway.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_SettlementGateway *SettlementGatewaySession) RenounceOwnership() (*types.Transaction, error) {
+	return _SettlementGateway.Contract.RenounceOwnership(&_SettlementGateway.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_SettlementGateway *SettlementGatewayTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _SettlementGateway.Contract.RenounceOwnership(&_SettlementGateway.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_SettlementGateway *SettlementGatewayTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _SettlementGateway.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_SettlementGateway *SettlementGatewaySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.TransferOwnership(&_SettlementGateway.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_SettlementGateway *SettlementGatewayTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _SettlementGateway.Contract.TransferOwnership(&_SettlementGateway.TransactOpts, newOwner)
+}
+
+// SettlementGatewayInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the SettlementGateway contract.
+type SettlementGatewayInitializedIterator struct {
+	Event *SettlementGatewayInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SettlementGatewayInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SettlementGatewayInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SettlementGatewayInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SettlementGatewayInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SettlementGatewayInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SettlementGatewayInitialized represents a Initialized event raised by the SettlementGateway contract.
+type SettlementGatewayInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_SettlementGateway *SettlementGatewayFilterer) FilterInitialized(opts *bind.FilterOpts) (*SettlementGatewayInitializedIterator, error) {
+
+	logs, sub, err := _SettlementGateway.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayInitializedIterator{contract: _SettlementGateway.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_SettlementGateway *SettlementGatewayFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *SettlementGatewayInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _SettlementGateway.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SettlementGatewayInitialized)
+				if err := _SettlementGateway.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_SettlementGateway *SettlementGatewayFilterer) ParseInitialized(log types.Log) (*SettlementGatewayInitialized, error) {
+	event := new(SettlementGatewayInitialized)
+	if err := _SettlementGateway.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SettlementGatewayOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the SettlementGateway contract.
+type SettlementGatewayOwnershipTransferredIterator struct {
+	Event *SettlementGatewayOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SettlementGatewayOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SettlementGatewayOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SettlementGatewayOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SettlementGatewayOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SettlementGatewayOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SettlementGatewayOwnershipTransferred represents a OwnershipTransferred event raised by the SettlementGateway contract.
+type SettlementGatewayOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_SettlementGateway *SettlementGatewayFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*SettlementGatewayOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _SettlementGateway.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayOwnershipTransferredIterator{contract: _SettlementGateway.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_SettlementGateway *SettlementGatewayFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *SettlementGatewayOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _SettlementGateway.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SettlementGatewayOwnershipTransferred)
+				if err := _SettlementGateway.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_SettlementGateway *SettlementGatewayFilterer) ParseOwnershipTransferred(log types.Log) (*SettlementGatewayOwnershipTransferred, error) {
+	event := new(SettlementGatewayOwnershipTransferred)
+	if err := _SettlementGateway.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SettlementGatewayTransferFinalizedIterator is returned from FilterTransferFinalized and is used to iterate over the raw logs and unpacked data for TransferFinalized events raised by the SettlementGateway contract.
+type SettlementGatewayTransferFinalizedIterator struct {
+	Event *SettlementGatewayTransferFinalized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SettlementGatewayTransferFinalizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SettlementGatewayTransferFinalized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SettlementGatewayTransferFinalized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SettlementGatewayTransferFinalizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SettlementGatewayTransferFinalizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SettlementGatewayTransferFinalized represents a TransferFinalized event raised by the SettlementGateway contract.
+type SettlementGatewayTransferFinalized struct {
+	Recipient       common.Address
+	Amount          *big.Int
+	CounterpartyIdx *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferFinalized is a free log retrieval operation binding the contract event 0x8c1419715bf9fd4efa8c0fd1833ba527cbdd86f6018aa79102af32103bbfdefd.
+//
+// Solidity: event TransferFinalized(address indexed recipient, uint256 amount, uint256 indexed counterpartyIdx)
+func (_SettlementGateway *SettlementGatewayFilterer) FilterTransferFinalized(opts *bind.FilterOpts, recipient []common.Address, counterpartyIdx []*big.Int) (*SettlementGatewayTransferFinalizedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var counterpartyIdxRule []interface{}
+	for _, counterpartyIdxItem := range counterpartyIdx {
+		counterpartyIdxRule = append(counterpartyIdxRule, counterpartyIdxItem)
+	}
+
+	logs, sub, err := _SettlementGateway.contract.FilterLogs(opts, "TransferFinalized", recipientRule, counterpartyIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayTransferFinalizedIterator{contract: _SettlementGateway.contract, event: "TransferFinalized", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferFinalized is a free log subscription operation binding the contract event 0x8c1419715bf9fd4efa8c0fd1833ba527cbdd86f6018aa79102af32103bbfdefd.
+//
+// Solidity: event TransferFinalized(address indexed recipient, uint256 amount, uint256 indexed counterpartyIdx)
+func (_SettlementGateway *SettlementGatewayFilterer) WatchTransferFinalized(opts *bind.WatchOpts, sink chan<- *SettlementGatewayTransferFinalized, recipient []common.Address, counterpartyIdx []*big.Int) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var counterpartyIdxRule []interface{}
+	for _, counterpartyIdxItem := range counterpartyIdx {
+		counterpartyIdxRule = append(counterpartyIdxRule, counterpartyIdxItem)
+	}
+
+	logs, sub, err := _SettlementGateway.contract.WatchLogs(opts, "TransferFinalized", recipientRule, counterpartyIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SettlementGatewayTransferFinalized)
+				if err := _SettlementGateway.contract.UnpackLog(event, "TransferFinalized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferFinalized is a log parse operation binding the contract event 0x8c1419715bf9fd4efa8c0fd1833ba527cbdd86f6018aa79102af32103bbfdefd.
+//
+// Solidity: event TransferFinalized(address indexed recipient, uint256 amount, uint256 indexed counterpartyIdx)
+func (_SettlementGateway *SettlementGatewayFilterer) ParseTransferFinalized(log types.Log) (*SettlementGatewayTransferFinalized, error) {
+	event := new(SettlementGatewayTransferFinalized)
+	if err := _SettlementGateway.contract.UnpackLog(event, "TransferFinalized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SettlementGatewayTransferInitiatedIterator is returned from FilterTransferInitiated and is used to iterate over the raw logs and unpacked data for TransferInitiated events raised by the SettlementGateway contract.
+type SettlementGatewayTransferInitiatedIterator struct {
+	Event *SettlementGatewayTransferInitiated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SettlementGatewayTransferInitiatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SettlementGatewayTransferInitiated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SettlementGatewayTransferInitiated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SettlementGatewayTransferInitiatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SettlementGatewayTransferInitiatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SettlementGatewayTransferInitiated represents a TransferInitiated event raised by the SettlementGateway contract.
+type SettlementGatewayTransferInitiated struct {
+	Sender      common.Address
+	Recipient   common.Address
+	Amount      *big.Int
+	TransferIdx *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferInitiated is a free log retrieval operation binding the contract event 0x6abe792a4e9e702afbc17fdac3c94f6ed1d8c9a8e4917c99672474b3f775ab43.
+//
+// Solidity: event TransferInitiated(address indexed sender, address indexed recipient, uint256 amount, uint256 indexed transferIdx)
+func (_SettlementGateway *SettlementGatewayFilterer) FilterTransferInitiated(opts *bind.FilterOpts, sender []common.Address, recipient []common.Address, transferIdx []*big.Int) (*SettlementGatewayTransferInitiatedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var transferIdxRule []interface{}
+	for _, transferIdxItem := range transferIdx {
+		transferIdxRule = append(transferIdxRule, transferIdxItem)
+	}
+
+	logs, sub, err := _SettlementGateway.contract.FilterLogs(opts, "TransferInitiated", senderRule, recipientRule, transferIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SettlementGatewayTransferInitiatedIterator{contract: _SettlementGateway.contract, event: "TransferInitiated", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferInitiated is a free log subscription operation binding the contract event 0x6abe792a4e9e702afbc17fdac3c94f6ed1d8c9a8e4917c99672474b3f775ab43.
+//
+// Solidity: event TransferInitiated(address indexed sender, address indexed recipient, uint256 amount, uint256 indexed transferIdx)
+func (_SettlementGateway *SettlementGatewayFilterer) WatchTransferInitiated(opts *bind.WatchOpts, sink chan<- *SettlementGatewayTransferInitiated, sender []common.Address, recipient []common.Address, transferIdx []*big.Int) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	var transferIdxRule []interface{}
+	for _, transferIdxItem := range transferIdx {
+		transferIdxRule = append(transferIdxRule, transferIdxItem)
+	}
+
+	logs, sub, err := _SettlementGateway.contract.WatchLogs(opts, "TransferInitiated", senderRule, recipientRule, transferIdxRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SettlementGatewayTransferInitiated)
+				if err := _SettlementGateway.contract.UnpackLog(event, "TransferInitiated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferInitiated is a log parse operation binding the contract event 0x6abe792a4e9e702afbc17fdac3c94f6ed1d8c9a8e4917c99672474b3f775ab43.
+//
+// Solidity: event TransferInitiated(address indexed sender, address indexed recipient, uint256 amount, uint256 indexed transferIdx)
+func (_SettlementGateway *SettlementGatewayFilterer) ParseTransferInitiated(log types.Log) (*SettlementGatewayTransferInitiated, error) {
+	event := new(SettlementGatewayTransferInitiated)
+	if err := _SettlementGateway.contract.UnpackLog(event, "TransferInitiated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
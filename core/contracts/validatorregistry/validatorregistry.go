@@ -0,0 +1,1479 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package validatorregistry
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ValidatorRegistryMetaData contains all meta data concerning the ValidatorRegistry contract.
+var ValidatorRegistryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"fallback\",\"stateMutability\":\"payable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"getBlocksTillWithdrawAllowed\",\"inputs\":[{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNumberOfStakedValidators\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getStakedAmount\",\"inputs\":[{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getStakedValidators\",\"inputs\":[{\"name\":\"start\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"end\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnstakingAmount\",\"inputs\":[{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"_minStake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_unstakePeriodBlocks\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isStaked\",\"inputs\":[{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minStake\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"stake\",\"inputs\":[{\"name\":\"valBLSPubKeys\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"stakeOriginators\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"stakedValsetVersion\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unstake\",\"inputs\":[{\"name\":\"blsPubKeys\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unstakeBlockNums\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unstakePeriodBlocks\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unstakingBalances\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"blsPubKeys\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"StakeWithdrawn\",\"inputs\":[{\"name\":\"txOriginator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Staked\",\"inputs\":[{\"name\":\"txOriginator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unstaked\",\"inputs\":[{\"name\":\"txOriginator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"valBLSPubKey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]}]",
+}
+
+// ValidatorRegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use ValidatorRegistryMetaData.ABI instead.
+var ValidatorRegistryABI = ValidatorRegistryMetaData.ABI
+
+// ValidatorRegistry is an auto generated Go binding around an Ethereum contract.
+type ValidatorRegistry struct {
+	ValidatorRegistryCaller     // Read-only binding to the contract
+	ValidatorRegistryTransactor // Write-only binding to the contract
+	ValidatorRegistryFilterer   // Log filterer for contract events
+}
+
+// ValidatorRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ValidatorRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ValidatorRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ValidatorRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValidatorRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ValidatorRegistrySession struct {
+	Contract     *ValidatorRegistry // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// ValidatorRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ValidatorRegistryCallerSession struct {
+	Contract *ValidatorRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// ValidatorRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ValidatorRegistryTransactorSession struct {
+	Contract     *ValidatorRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// ValidatorRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ValidatorRegistryRaw struct {
+	Contract *ValidatorRegistry // Generic contract binding to access the raw methods on
+}
+
+// ValidatorRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ValidatorRegistryCallerRaw struct {
+	Contract *ValidatorRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ValidatorRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ValidatorRegistryTransactorRaw struct {
+	Contract *ValidatorRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewValidatorRegistry creates a new instance of ValidatorRegistry, bound to a specific deployed contract.
+func NewValidatorRegistry(address common.Address, backend bind.ContractBackend) (*ValidatorRegistry, error) {
+	contract, err := bindValidatorRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistry{ValidatorRegistryCaller: ValidatorRegistryCaller{contract: contract}, ValidatorRegistryTransactor: ValidatorRegistryTransactor{contract: contract}, ValidatorRegistryFilterer: ValidatorRegistryFilterer{contract: contract}}, nil
+}
+
+// NewValidatorRegistryCaller creates a new read-only instance of ValidatorRegistry, bound to a specific deployed contract.
+func NewValidatorRegistryCaller(address common.Address, caller bind.ContractCaller) (*ValidatorRegistryCaller, error) {
+	contract, err := bindValidatorRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryCaller{contract: contract}, nil
+}
+
+// NewValidatorRegistryTransactor creates a new write-only instance of ValidatorRegistry, bound to a specific deployed contract.
+func NewValidatorRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*ValidatorRegistryTransactor, error) {
+	contract, err := bindValidatorRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryTransactor{contract: contract}, nil
+}
+
+// NewValidatorRegistryFilterer creates a new log filterer instance of ValidatorRegistry, bound to a specific deployed contract.
+func NewValidatorRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*ValidatorRegistryFilterer, error) {
+	contract, err := bindValidatorRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryFilterer{contract: contract}, nil
+}
+
+// bindValidatorRegistry binds a generic wrapper to an already deployed contract.
+func bindValidatorRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ValidatorRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ValidatorRegistry *ValidatorRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ValidatorRegistry.Contract.ValidatorRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ValidatorRegistry *ValidatorRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.ValidatorRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ValidatorRegistry *ValidatorRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.ValidatorRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ValidatorRegistry *ValidatorRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ValidatorRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ValidatorRegistry *ValidatorRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ValidatorRegistry *ValidatorRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetBlocksTillWithdrawAllowed is a free data retrieval call binding the contract method 0x14699cb9.
+//
+// Solidity: function getBlocksTillWithdrawAllowed(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) GetBlocksTillWithdrawAllowed(opts *bind.CallOpts, valBLSPubKey []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "getBlocksTillWithdrawAllowed", valBLSPubKey)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBlocksTillWithdrawAllowed is a free data retrieval call binding the contract method 0x14699cb9.
+//
+// Solidity: function getBlocksTillWithdrawAllowed(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) GetBlocksTillWithdrawAllowed(valBLSPubKey []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.GetBlocksTillWithdrawAllowed(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// GetBlocksTillWithdrawAllowed is a free data retrieval call binding the contract method 0x14699cb9.
+//
+// Solidity: function getBlocksTillWithdrawAllowed(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) GetBlocksTillWithdrawAllowed(valBLSPubKey []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.GetBlocksTillWithdrawAllowed(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// GetNumberOfStakedValidators is a free data retrieval call binding the contract method 0x07258504.
+//
+// Solidity: function getNumberOfStakedValidators() view returns(uint256, uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) GetNumberOfStakedValidators(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "getNumberOfStakedValidators")
+
+	if err != nil {
+		return *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetNumberOfStakedValidators is a free data retrieval call binding the contract method 0x07258504.
+//
+// Solidity: function getNumberOfStakedValidators() view returns(uint256, uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) GetNumberOfStakedValidators() (*big.Int, *big.Int, error) {
+	return _ValidatorRegistry.Contract.GetNumberOfStakedValidators(&_ValidatorRegistry.CallOpts)
+}
+
+// GetNumberOfStakedValidators is a free data retrieval call binding the contract method 0x07258504.
+//
+// Solidity: function getNumberOfStakedValidators() view returns(uint256, uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) GetNumberOfStakedValidators() (*big.Int, *big.Int, error) {
+	return _ValidatorRegistry.Contract.GetNumberOfStakedValidators(&_ValidatorRegistry.CallOpts)
+}
+
+// GetStakedAmount is a free data retrieval call binding the contract method 0xb2a453e6.
+//
+// Solidity: function getStakedAmount(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) GetStakedAmount(opts *bind.CallOpts, valBLSPubKey []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "getStakedAmount", valBLSPubKey)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetStakedAmount is a free data retrieval call binding the contract method 0xb2a453e6.
+//
+// Solidity: function getStakedAmount(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) GetStakedAmount(valBLSPubKey []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.GetStakedAmount(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// GetStakedAmount is a free data retrieval call binding the contract method 0xb2a453e6.
+//
+// Solidity: function getStakedAmount(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) GetStakedAmount(valBLSPubKey []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.GetStakedAmount(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// GetStakedValidators is a free data retrieval call binding the contract method 0x7d377923.
+//
+// Solidity: function getStakedValidators(uint256 start, uint256 end) view returns(bytes[], uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) GetStakedValidators(opts *bind.CallOpts, start *big.Int, end *big.Int) ([][]byte, *big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "getStakedValidators", start, end)
+
+	if err != nil {
+		return *new([][]byte), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][]byte)).(*[][]byte)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetStakedValidators is a free data retrieval call binding the contract method 0x7d377923.
+//
+// Solidity: function getStakedValidators(uint256 start, uint256 end) view returns(bytes[], uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) GetStakedValidators(start *big.Int, end *big.Int) ([][]byte, *big.Int, error) {
+	return _ValidatorRegistry.Contract.GetStakedValidators(&_ValidatorRegistry.CallOpts, start, end)
+}
+
+// GetStakedValidators is a free data retrieval call binding the contract method 0x7d377923.
+//
+// Solidity: function getStakedValidators(uint256 start, uint256 end) view returns(bytes[], uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) GetStakedValidators(start *big.Int, end *big.Int) ([][]byte, *big.Int, error) {
+	return _ValidatorRegistry.Contract.GetStakedValidators(&_ValidatorRegistry.CallOpts, start, end)
+}
+
+// GetUnstakingAmount is a free data retrieval call binding the contract method 0xa812e103.
+//
+// Solidity: function getUnstakingAmount(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) GetUnstakingAmount(opts *bind.CallOpts, valBLSPubKey []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "getUnstakingAmount", valBLSPubKey)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUnstakingAmount is a free data retrieval call binding the contract method 0xa812e103.
+//
+// Solidity: function getUnstakingAmount(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) GetUnstakingAmount(valBLSPubKey []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.GetUnstakingAmount(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// GetUnstakingAmount is a free data retrieval call binding the contract method 0xa812e103.
+//
+// Solidity: function getUnstakingAmount(bytes valBLSPubKey) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) GetUnstakingAmount(valBLSPubKey []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.GetUnstakingAmount(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// IsStaked is a free data retrieval call binding the contract method 0xcdb513b4.
+//
+// Solidity: function isStaked(bytes valBLSPubKey) view returns(bool)
+func (_ValidatorRegistry *ValidatorRegistryCaller) IsStaked(opts *bind.CallOpts, valBLSPubKey []byte) (bool, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "isStaked", valBLSPubKey)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsStaked is a free data retrieval call binding the contract method 0xcdb513b4.
+//
+// Solidity: function isStaked(bytes valBLSPubKey) view returns(bool)
+func (_ValidatorRegistry *ValidatorRegistrySession) IsStaked(valBLSPubKey []byte) (bool, error) {
+	return _ValidatorRegistry.Contract.IsStaked(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// IsStaked is a free data retrieval call binding the contract method 0xcdb513b4.
+//
+// Solidity: function isStaked(bytes valBLSPubKey) view returns(bool)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) IsStaked(valBLSPubKey []byte) (bool, error) {
+	return _ValidatorRegistry.Contract.IsStaked(&_ValidatorRegistry.CallOpts, valBLSPubKey)
+}
+
+// MinStake is a free data retrieval call binding the contract method 0x375b3c0a.
+//
+// Solidity: function minStake() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) MinStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "minStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinStake is a free data retrieval call binding the contract method 0x375b3c0a.
+//
+// Solidity: function minStake() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) MinStake() (*big.Int, error) {
+	return _ValidatorRegistry.Contract.MinStake(&_ValidatorRegistry.CallOpts)
+}
+
+// MinStake is a free data retrieval call binding the contract method 0x375b3c0a.
+//
+// Solidity: function minStake() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) MinStake() (*big.Int, error) {
+	return _ValidatorRegistry.Contract.MinStake(&_ValidatorRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ValidatorRegistry *ValidatorRegistryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ValidatorRegistry *ValidatorRegistrySession) Owner() (common.Address, error) {
+	return _ValidatorRegistry.Contract.Owner(&_ValidatorRegistry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) Owner() (common.Address, error) {
+	return _ValidatorRegistry.Contract.Owner(&_ValidatorRegistry.CallOpts)
+}
+
+// StakeOriginators is a free data retrieval call binding the contract method 0x2e5b5fd7.
+//
+// Solidity: function stakeOriginators(bytes ) view returns(address)
+func (_ValidatorRegistry *ValidatorRegistryCaller) StakeOriginators(opts *bind.CallOpts, arg0 []byte) (common.Address, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "stakeOriginators", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// StakeOriginators is a free data retrieval call binding the contract method 0x2e5b5fd7.
+//
+// Solidity: function stakeOriginators(bytes ) view returns(address)
+func (_ValidatorRegistry *ValidatorRegistrySession) StakeOriginators(arg0 []byte) (common.Address, error) {
+	return _ValidatorRegistry.Contract.StakeOriginators(&_ValidatorRegistry.CallOpts, arg0)
+}
+
+// StakeOriginators is a free data retrieval call binding the contract method 0x2e5b5fd7.
+//
+// Solidity: function stakeOriginators(bytes ) view returns(address)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) StakeOriginators(arg0 []byte) (common.Address, error) {
+	return _ValidatorRegistry.Contract.StakeOriginators(&_ValidatorRegistry.CallOpts, arg0)
+}
+
+// StakedValsetVersion is a free data retrieval call binding the contract method 0xd628ee62.
+//
+// Solidity: function stakedValsetVersion() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) StakedValsetVersion(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "stakedValsetVersion")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StakedValsetVersion is a free data retrieval call binding the contract method 0xd628ee62.
+//
+// Solidity: function stakedValsetVersion() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) StakedValsetVersion() (*big.Int, error) {
+	return _ValidatorRegistry.Contract.StakedValsetVersion(&_ValidatorRegistry.CallOpts)
+}
+
+// StakedValsetVersion is a free data retrieval call binding the contract method 0xd628ee62.
+//
+// Solidity: function stakedValsetVersion() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) StakedValsetVersion() (*big.Int, error) {
+	return _ValidatorRegistry.Contract.StakedValsetVersion(&_ValidatorRegistry.CallOpts)
+}
+
+// UnstakeBlockNums is a free data retrieval call binding the contract method 0x2f8836a5.
+//
+// Solidity: function unstakeBlockNums(bytes ) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) UnstakeBlockNums(opts *bind.CallOpts, arg0 []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "unstakeBlockNums", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UnstakeBlockNums is a free data retrieval call binding the contract method 0x2f8836a5.
+//
+// Solidity: function unstakeBlockNums(bytes ) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) UnstakeBlockNums(arg0 []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.UnstakeBlockNums(&_ValidatorRegistry.CallOpts, arg0)
+}
+
+// UnstakeBlockNums is a free data retrieval call binding the contract method 0x2f8836a5.
+//
+// Solidity: function unstakeBlockNums(bytes ) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) UnstakeBlockNums(arg0 []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.UnstakeBlockNums(&_ValidatorRegistry.CallOpts, arg0)
+}
+
+// UnstakePeriodBlocks is a free data retrieval call binding the contract method 0xc253f765.
+//
+// Solidity: function unstakePeriodBlocks() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) UnstakePeriodBlocks(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "unstakePeriodBlocks")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UnstakePeriodBlocks is a free data retrieval call binding the contract method 0xc253f765.
+//
+// Solidity: function unstakePeriodBlocks() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) UnstakePeriodBlocks() (*big.Int, error) {
+	return _ValidatorRegistry.Contract.UnstakePeriodBlocks(&_ValidatorRegistry.CallOpts)
+}
+
+// UnstakePeriodBlocks is a free data retrieval call binding the contract method 0xc253f765.
+//
+// Solidity: function unstakePeriodBlocks() view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) UnstakePeriodBlocks() (*big.Int, error) {
+	return _ValidatorRegistry.Contract.UnstakePeriodBlocks(&_ValidatorRegistry.CallOpts)
+}
+
+// UnstakingBalances is a free data retrieval call binding the contract method 0xfe6c470c.
+//
+// Solidity: function unstakingBalances(bytes ) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCaller) UnstakingBalances(opts *bind.CallOpts, arg0 []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ValidatorRegistry.contract.Call(opts, &out, "unstakingBalances", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UnstakingBalances is a free data retrieval call binding the contract method 0xfe6c470c.
+//
+// Solidity: function unstakingBalances(bytes ) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistrySession) UnstakingBalances(arg0 []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.UnstakingBalances(&_ValidatorRegistry.CallOpts, arg0)
+}
+
+// UnstakingBalances is a free data retrieval call binding the contract method 0xfe6c470c.
+//
+// Solidity: function unstakingBalances(bytes ) view returns(uint256)
+func (_ValidatorRegistry *ValidatorRegistryCallerSession) UnstakingBalances(arg0 []byte) (*big.Int, error) {
+	return _ValidatorRegistry.Contract.UnstakingBalances(&_ValidatorRegistry.CallOpts, arg0)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6ab36f2.
+//
+// Solidity: function initialize(uint256 _minStake, uint256 _unstakePeriodBlocks, address _owner) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) Initialize(opts *bind.TransactOpts, _minStake *big.Int, _unstakePeriodBlocks *big.Int, _owner common.Address) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.Transact(opts, "initialize", _minStake, _unstakePeriodBlocks, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6ab36f2.
+//
+// Solidity: function initialize(uint256 _minStake, uint256 _unstakePeriodBlocks, address _owner) returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) Initialize(_minStake *big.Int, _unstakePeriodBlocks *big.Int, _owner common.Address) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Initialize(&_ValidatorRegistry.TransactOpts, _minStake, _unstakePeriodBlocks, _owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xa6ab36f2.
+//
+// Solidity: function initialize(uint256 _minStake, uint256 _unstakePeriodBlocks, address _owner) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) Initialize(_minStake *big.Int, _unstakePeriodBlocks *big.Int, _owner common.Address) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Initialize(&_ValidatorRegistry.TransactOpts, _minStake, _unstakePeriodBlocks, _owner)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) RenounceOwnership() (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.RenounceOwnership(&_ValidatorRegistry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.RenounceOwnership(&_ValidatorRegistry.TransactOpts)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x7299e0e6.
+//
+// Solidity: function stake(bytes[] valBLSPubKeys) payable returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) Stake(opts *bind.TransactOpts, valBLSPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.Transact(opts, "stake", valBLSPubKeys)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x7299e0e6.
+//
+// Solidity: function stake(bytes[] valBLSPubKeys) payable returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) Stake(valBLSPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Stake(&_ValidatorRegistry.TransactOpts, valBLSPubKeys)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x7299e0e6.
+//
+// Solidity: function stake(bytes[] valBLSPubKeys) payable returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) Stake(valBLSPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Stake(&_ValidatorRegistry.TransactOpts, valBLSPubKeys)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.TransferOwnership(&_ValidatorRegistry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.TransferOwnership(&_ValidatorRegistry.TransactOpts, newOwner)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc08a2081.
+//
+// Solidity: function unstake(bytes[] blsPubKeys) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) Unstake(opts *bind.TransactOpts, blsPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.Transact(opts, "unstake", blsPubKeys)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc08a2081.
+//
+// Solidity: function unstake(bytes[] blsPubKeys) returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) Unstake(blsPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Unstake(&_ValidatorRegistry.TransactOpts, blsPubKeys)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc08a2081.
+//
+// Solidity: function unstake(bytes[] blsPubKeys) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) Unstake(blsPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Unstake(&_ValidatorRegistry.TransactOpts, blsPubKeys)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xdcb1edcb.
+//
+// Solidity: function withdraw(bytes[] blsPubKeys) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) Withdraw(opts *bind.TransactOpts, blsPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.Transact(opts, "withdraw", blsPubKeys)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xdcb1edcb.
+//
+// Solidity: function withdraw(bytes[] blsPubKeys) returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) Withdraw(blsPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Withdraw(&_ValidatorRegistry.TransactOpts, blsPubKeys)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xdcb1edcb.
+//
+// Solidity: function withdraw(bytes[] blsPubKeys) returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) Withdraw(blsPubKeys [][]byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Withdraw(&_ValidatorRegistry.TransactOpts, blsPubKeys)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Fallback(&_ValidatorRegistry.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Fallback(&_ValidatorRegistry.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValidatorRegistry.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ValidatorRegistry *ValidatorRegistrySession) Receive() (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Receive(&_ValidatorRegistry.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ValidatorRegistry *ValidatorRegistryTransactorSession) Receive() (*types.Transaction, error) {
+	return _ValidatorRegistry.Contract.Receive(&_ValidatorRegistry.TransactOpts)
+}
+
+// ValidatorRegistryInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ValidatorRegistry contract.
+type ValidatorRegistryInitializedIterator struct {
+	Event *ValidatorRegistryInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorRegistryInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorRegistryInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorRegistryInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorRegistryInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorRegistryInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorRegistryInitialized represents a Initialized event raised by the ValidatorRegistry contract.
+type ValidatorRegistryInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) FilterInitialized(opts *bind.FilterOpts) (*ValidatorRegistryInitializedIterator, error) {
+
+	logs, sub, err := _ValidatorRegistry.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryInitializedIterator{contract: _ValidatorRegistry.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ValidatorRegistryInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _ValidatorRegistry.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorRegistryInitialized)
+				if err := _ValidatorRegistry.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) ParseInitialized(log types.Log) (*ValidatorRegistryInitialized, error) {
+	event := new(ValidatorRegistryInitialized)
+	if err := _ValidatorRegistry.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorRegistryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ValidatorRegistry contract.
+type ValidatorRegistryOwnershipTransferredIterator struct {
+	Event *ValidatorRegistryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorRegistryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorRegistryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorRegistryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorRegistryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorRegistryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorRegistryOwnershipTransferred represents a OwnershipTransferred event raised by the ValidatorRegistry contract.
+type ValidatorRegistryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ValidatorRegistryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryOwnershipTransferredIterator{contract: _ValidatorRegistry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ValidatorRegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorRegistryOwnershipTransferred)
+				if err := _ValidatorRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) ParseOwnershipTransferred(log types.Log) (*ValidatorRegistryOwnershipTransferred, error) {
+	event := new(ValidatorRegistryOwnershipTransferred)
+	if err := _ValidatorRegistry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorRegistryStakeWithdrawnIterator is returned from FilterStakeWithdrawn and is used to iterate over the raw logs and unpacked data for StakeWithdrawn events raised by the ValidatorRegistry contract.
+type ValidatorRegistryStakeWithdrawnIterator struct {
+	Event *ValidatorRegistryStakeWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorRegistryStakeWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorRegistryStakeWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorRegistryStakeWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorRegistryStakeWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorRegistryStakeWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorRegistryStakeWithdrawn represents a StakeWithdrawn event raised by the ValidatorRegistry contract.
+type ValidatorRegistryStakeWithdrawn struct {
+	TxOriginator common.Address
+	ValBLSPubKey []byte
+	Amount       *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterStakeWithdrawn is a free log retrieval operation binding the contract event 0x60e5b516955702ed9d33ecfc7eaaf6b2f5cea6bd67820e5e4f0096eed587c29b.
+//
+// Solidity: event StakeWithdrawn(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) FilterStakeWithdrawn(opts *bind.FilterOpts, txOriginator []common.Address) (*ValidatorRegistryStakeWithdrawnIterator, error) {
+
+	var txOriginatorRule []interface{}
+	for _, txOriginatorItem := range txOriginator {
+		txOriginatorRule = append(txOriginatorRule, txOriginatorItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.FilterLogs(opts, "StakeWithdrawn", txOriginatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryStakeWithdrawnIterator{contract: _ValidatorRegistry.contract, event: "StakeWithdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchStakeWithdrawn is a free log subscription operation binding the contract event 0x60e5b516955702ed9d33ecfc7eaaf6b2f5cea6bd67820e5e4f0096eed587c29b.
+//
+// Solidity: event StakeWithdrawn(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) WatchStakeWithdrawn(opts *bind.WatchOpts, sink chan<- *ValidatorRegistryStakeWithdrawn, txOriginator []common.Address) (event.Subscription, error) {
+
+	var txOriginatorRule []interface{}
+	for _, txOriginatorItem := range txOriginator {
+		txOriginatorRule = append(txOriginatorRule, txOriginatorItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.WatchLogs(opts, "StakeWithdrawn", txOriginatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorRegistryStakeWithdrawn)
+				if err := _ValidatorRegistry.contract.UnpackLog(event, "StakeWithdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStakeWithdrawn is a log parse operation binding the contract event 0x60e5b516955702ed9d33ecfc7eaaf6b2f5cea6bd67820e5e4f0096eed587c29b.
+//
+// Solidity: event StakeWithdrawn(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) ParseStakeWithdrawn(log types.Log) (*ValidatorRegistryStakeWithdrawn, error) {
+	event := new(ValidatorRegistryStakeWithdrawn)
+	if err := _ValidatorRegistry.contract.UnpackLog(event, "StakeWithdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorRegistryStakedIterator is returned from FilterStaked and is used to iterate over the raw logs and unpacked data for Staked events raised by the ValidatorRegistry contract.
+type ValidatorRegistryStakedIterator struct {
+	Event *ValidatorRegistryStaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorRegistryStakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorRegistryStaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorRegistryStaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorRegistryStakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorRegistryStakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorRegistryStaked represents a Staked event raised by the ValidatorRegistry contract.
+type ValidatorRegistryStaked struct {
+	TxOriginator common.Address
+	ValBLSPubKey []byte
+	Amount       *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterStaked is a free log retrieval operation binding the contract event 0xf4679d394f1f97f1a3df1d73e193866ec5a813168ad5fa6958f9be21b10a594e.
+//
+// Solidity: event Staked(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) FilterStaked(opts *bind.FilterOpts, txOriginator []common.Address) (*ValidatorRegistryStakedIterator, error) {
+
+	var txOriginatorRule []interface{}
+	for _, txOriginatorItem := range txOriginator {
+		txOriginatorRule = append(txOriginatorRule, txOriginatorItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.FilterLogs(opts, "Staked", txOriginatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryStakedIterator{contract: _ValidatorRegistry.contract, event: "Staked", logs: logs, sub: sub}, nil
+}
+
+// WatchStaked is a free log subscription operation binding the contract event 0xf4679d394f1f97f1a3df1d73e193866ec5a813168ad5fa6958f9be21b10a594e.
+//
+// Solidity: event Staked(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) WatchStaked(opts *bind.WatchOpts, sink chan<- *ValidatorRegistryStaked, txOriginator []common.Address) (event.Subscription, error) {
+
+	var txOriginatorRule []interface{}
+	for _, txOriginatorItem := range txOriginator {
+		txOriginatorRule = append(txOriginatorRule, txOriginatorItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.WatchLogs(opts, "Staked", txOriginatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorRegistryStaked)
+				if err := _ValidatorRegistry.contract.UnpackLog(event, "Staked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStaked is a log parse operation binding the contract event 0xf4679d394f1f97f1a3df1d73e193866ec5a813168ad5fa6958f9be21b10a594e.
+//
+// Solidity: event Staked(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) ParseStaked(log types.Log) (*ValidatorRegistryStaked, error) {
+	event := new(ValidatorRegistryStaked)
+	if err := _ValidatorRegistry.contract.UnpackLog(event, "Staked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ValidatorRegistryUnstakedIterator is returned from FilterUnstaked and is used to iterate over the raw logs and unpacked data for Unstaked events raised by the ValidatorRegistry contract.
+type ValidatorRegistryUnstakedIterator struct {
+	Event *ValidatorRegistryUnstaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ValidatorRegistryUnstakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ValidatorRegistryUnstaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ValidatorRegistryUnstaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ValidatorRegistryUnstakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ValidatorRegistryUnstakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ValidatorRegistryUnstaked represents a Unstaked event raised by the ValidatorRegistry contract.
+type ValidatorRegistryUnstaked struct {
+	TxOriginator common.Address
+	ValBLSPubKey []byte
+	Amount       *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnstaked is a free log retrieval operation binding the contract event 0x34f6c826286e3bec36208d054dcb1ad3c49725237a7644e1a6d157a92ae7a3e1.
+//
+// Solidity: event Unstaked(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) FilterUnstaked(opts *bind.FilterOpts, txOriginator []common.Address) (*ValidatorRegistryUnstakedIterator, error) {
+
+	var txOriginatorRule []interface{}
+	for _, txOriginatorItem := range txOriginator {
+		txOriginatorRule = append(txOriginatorRule, txOriginatorItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.FilterLogs(opts, "Unstaked", txOriginatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorRegistryUnstakedIterator{contract: _ValidatorRegistry.contract, event: "Unstaked", logs: logs, sub: sub}, nil
+}
+
+// WatchUnstaked is a free log subscription operation binding the contract event 0x34f6c826286e3bec36208d054dcb1ad3c49725237a7644e1a6d157a92ae7a3e1.
+//
+// Solidity: event Unstaked(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) WatchUnstaked(opts *bind.WatchOpts, sink chan<- *ValidatorRegistryUnstaked, txOriginator []common.Address) (event.Subscription, error) {
+
+	var txOriginatorRule []interface{}
+	for _, txOriginatorItem := range txOriginator {
+		txOriginatorRule = append(txOriginatorRule, txOriginatorItem)
+	}
+
+	logs, sub, err := _ValidatorRegistry.contract.WatchLogs(opts, "Unstaked", txOriginatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ValidatorRegistryUnstaked)
+				if err := _ValidatorRegistry.contract.UnpackLog(event, "Unstaked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnstaked is a log parse operation binding the contract event 0x34f6c826286e3bec36208d054dcb1ad3c49725237a7644e1a6d157a92ae7a3e1.
+//
+// Solidity: event Unstaked(address indexed txOriginator, bytes valBLSPubKey, uint256 amount)
+func (_ValidatorRegistry *ValidatorRegistryFilterer) ParseUnstaked(log types.Log) (*ValidatorRegistryUnstaked, error) {
+	event := new(ValidatorRegistryUnstaked)
+	if err := _ValidatorRegistry.contract.UnpackLog(event, "Unstaked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,76 @@
+package eth
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+// Blob payload encoding. A payload is prefixed with a header, a version byte and its length as a big-endian
+// uint32, and the result is written 31 bytes at a time into the field elements of consecutive blobs. The first
+// byte of every 32-byte field element stays zero, which keeps each element below the BLS modulus.
+const (
+	// BlobCodecVersion is the version byte written in the header of encoded payloads.
+	BlobCodecVersion byte = 0
+	// MaxBlobsPerTx is the most blobs a single blob transaction can carry.
+	MaxBlobsPerTx = 6
+
+	usableBytesPerFieldElement = gokzg4844.SerializedScalarSize - 1
+	fieldElementsPerBlob       = len(kzg4844.Blob{}) / gokzg4844.SerializedScalarSize
+	usableBytesPerBlob         = fieldElementsPerBlob * usableBytesPerFieldElement
+	blobHeaderSize             = 5
+)
+
+// BlobsNeeded returns how many blobs EncodeBlobs uses for a payload of n bytes.
+func BlobsNeeded(n int) int {
+	return (blobHeaderSize + n + usableBytesPerBlob - 1) / usableBytesPerBlob
+}
+
+// EncodeBlobs packs data into as many blobs as needed. DecodeBlobs gives the data back.
+func EncodeBlobs(data []byte) ([]kzg4844.Blob, error) {
+	if uint64(len(data)) > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("payload of %d bytes is too large to encode", len(data))
+	}
+
+	stream := make([]byte, blobHeaderSize+len(data))
+	stream[0] = BlobCodecVersion
+	binary.BigEndian.PutUint32(stream[1:blobHeaderSize], uint32(len(data)))
+	copy(stream[blobHeaderSize:], data)
+
+	blobs := make([]kzg4844.Blob, BlobsNeeded(len(data)))
+	for i := 0; len(stream) > 0; i++ {
+		blob := &blobs[i/fieldElementsPerBlob]
+		offset := (i%fieldElementsPerBlob)*gokzg4844.SerializedScalarSize + 1
+		n := copy(blob[offset:offset+usableBytesPerFieldElement], stream)
+		stream = stream[n:]
+	}
+	return blobs, nil
+}
+
+// DecodeBlobs returns the payload encoded in blobs by EncodeBlobs.
+func DecodeBlobs(blobs []kzg4844.Blob) ([]byte, error) {
+	if len(blobs) == 0 {
+		return nil, fmt.Errorf("no blobs to decode")
+	}
+
+	stream := make([]byte, 0, len(blobs)*usableBytesPerBlob)
+	for b := range blobs {
+		for i := 0; i < len(blobs[b]); i += gokzg4844.SerializedScalarSize {
+			if blobs[b][i] != 0 {
+				return nil, fmt.Errorf("blob %d field element %d is not a payload encoding: first byte is %#x", b, i/gokzg4844.SerializedScalarSize, blobs[b][i])
+			}
+			stream = append(stream, blobs[b][i+1:i+gokzg4844.SerializedScalarSize]...)
+		}
+	}
+
+	if version := stream[0]; version != BlobCodecVersion {
+		return nil, fmt.Errorf("unsupported blob payload version %d", version)
+	}
+	length := uint64(binary.BigEndian.Uint32(stream[1:blobHeaderSize]))
+	if length > uint64(len(stream)-blobHeaderSize) {
+		return nil, fmt.Errorf("blob payload length %d is more than the %d bytes %d blobs can hold", length, len(stream)-blobHeaderSize, len(blobs))
+	}
+	return stream[blobHeaderSize : blobHeaderSize+int(length)], nil
+}
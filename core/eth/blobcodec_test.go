@@ -0,0 +1,89 @@
+package eth
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+func TestBlobCodecRoundTrip(t *testing.T) {
+	sizes := []int{
+		0,
+		1,
+		usableBytesPerFieldElement - blobHeaderSize,
+		usableBytesPerFieldElement - blobHeaderSize + 1,
+		usableBytesPerBlob - blobHeaderSize,
+		usableBytesPerBlob - blobHeaderSize + 1,
+		3*usableBytesPerBlob + 1000,
+		MaxBlobsPerTx*usableBytesPerBlob - blobHeaderSize,
+	}
+
+	for _, size := range sizes {
+		data := make([]byte, size)
+		if _, err := rand.Read(data); err != nil {
+			t.Fatal(err)
+		}
+
+		blobs, err := EncodeBlobs(data)
+		if err != nil {
+			t.Fatalf("size %d: encode: %v", size, err)
+		}
+		if len(blobs) != BlobsNeeded(size) {
+			t.Errorf("size %d: got %d blobs, BlobsNeeded says %d", size, len(blobs), BlobsNeeded(size))
+		}
+
+		decoded, err := DecodeBlobs(blobs)
+		if err != nil {
+			t.Fatalf("size %d: decode: %v", size, err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Errorf("size %d: decoded payload differs from the original", size)
+		}
+	}
+}
+
+func TestBlobCodecBlobsAreValid(t *testing.T) {
+	data := bytes.Repeat([]byte{0xff}, usableBytesPerBlob+1)
+	blobs, err := EncodeBlobs(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// all-0xff elements would be above the BLS modulus without the zero first byte
+	for i := range blobs {
+		if _, err := kzg4844.BlobToCommitment(&blobs[i]); err != nil {
+			t.Errorf("blob %d is not valid: %v", i, err)
+		}
+	}
+}
+
+func TestDecodeBlobsErrors(t *testing.T) {
+	if _, err := DecodeBlobs(nil); err == nil {
+		t.Error("expected an error for no blobs")
+	}
+
+	blobs, err := EncodeBlobs([]byte("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	badVersion := blobs[0]
+	badVersion[1] = BlobCodecVersion + 1
+	if _, err := DecodeBlobs([]kzg4844.Blob{badVersion}); err == nil {
+		t.Error("expected an error for an unknown version")
+	}
+
+	badLength := blobs[0]
+	badLength[2] = 0xff
+	if _, err := DecodeBlobs([]kzg4844.Blob{badLength}); err == nil {
+		t.Error("expected an error for a length larger than the blobs")
+	}
+
+	badElement := blobs[0]
+	badElement[32] = 1
+	if _, err := DecodeBlobs([]kzg4844.Blob{badElement}); err == nil {
+		t.Error("expected an error for a non-zero first byte")
+	}
+}
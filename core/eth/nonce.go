@@ -0,0 +1,155 @@
+package eth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// NonceManager hands out the nonces of one account locally, so several txs can be in flight at once without
+// asking the node for the pending nonce before each send. It reads the pending nonce again when a send shows
+// the local count is off: a nonce rejected as too low or already in use, or a nonce that was handed out but
+// never sent while later ones were. Check finds txs the node dropped. It is safe for concurrent use.
+type NonceManager struct {
+	mu      sync.Mutex
+	client  *ethclient.Client
+	address common.Address
+	next    uint64
+	synced  bool
+	// outstanding is the nonces handed out that the node's pending nonce has not passed yet.
+	outstanding map[uint64]bool
+}
+
+// nonceKey identifies the nonce manager of an account on a chain.
+type nonceKey struct {
+	client  *ethclient.Client
+	address common.Address
+}
+
+var (
+	noncesMu sync.Mutex
+	nonces   = make(map[nonceKey]*NonceManager)
+)
+
+// Nonces returns the nonce manager of address on the chain of client, creating it on first use. Every send from
+// the account should take its nonce from it.
+func Nonces(client *ethclient.Client, address common.Address) *NonceManager {
+	noncesMu.Lock()
+	defer noncesMu.Unlock()
+
+	key := nonceKey{client: client, address: address}
+	if m, ok := nonces[key]; ok {
+		return m
+	}
+	m := &NonceManager{client: client, address: address, outstanding: make(map[uint64]bool)}
+	nonces[key] = m
+	return m
+}
+
+// Next returns the nonce for the next tx. Every nonce must be passed back to Done once the tx was sent or the
+// send failed.
+func (m *NonceManager) Next(ctx context.Context) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.sync(ctx); err != nil {
+			return 0, err
+		}
+	}
+	nonce := m.next
+	m.next++
+	m.outstanding[nonce] = true
+	return nonce, nil
+}
+
+// Done reports the result of sending a tx with nonce. A nonce that wasn't used is handed out again if it was the
+// last one, otherwise the next call to Next resyncs with the node to close the gap.
+func (m *NonceManager) Done(nonce uint64, err error) {
+	if err == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.outstanding, nonce)
+	switch {
+	case isNonceError(err):
+		log.Warn("Nonce rejected, resyncing with the node", "address", m.address, "nonce", nonce, "error", err)
+		m.synced = false
+	case m.synced && nonce+1 == m.next:
+		m.next = nonce
+	default:
+		log.Warn("Nonce left a gap, resyncing with the node", "address", m.address, "nonce", nonce, "next", m.next)
+		m.synced = false
+	}
+}
+
+// Check compares the node's pending nonce with the nonces handed out. Once the node knew a tx, its pending nonce
+// only falls below the lowest nonce still outstanding if the tx was dropped from the mempool. The nonces from
+// there on are handed out again instead of waiting forever for the dropped tx, and Check returns true.
+func (m *NonceManager) Check(ctx context.Context) (bool, error) {
+	pending, err := m.client.PendingNonceAt(ctx, m.address)
+	if err != nil {
+		return false, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		return false, nil
+	}
+	lowest := m.next
+	for nonce := range m.outstanding {
+		if nonce < pending {
+			delete(m.outstanding, nonce)
+		} else if nonce < lowest {
+			lowest = nonce
+		}
+	}
+	if pending >= lowest {
+		return false, nil
+	}
+	log.Warn("Pending nonce fell behind, a tx was dropped; resyncing", "address", m.address, "pending", pending, "next", m.next)
+	m.next = pending
+	return true, nil
+}
+
+// Resync makes the next call to Next read the pending nonce from the node again.
+func (m *NonceManager) Resync() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.synced = false
+}
+
+func (m *NonceManager) sync(ctx context.Context) error {
+	nonce, err := m.client.PendingNonceAt(ctx, m.address)
+	if err != nil {
+		return err
+	}
+	m.next = nonce
+	m.synced = true
+	for n := range m.outstanding {
+		if n < nonce {
+			delete(m.outstanding, n)
+		}
+	}
+	return nil
+}
+
+// isNonceError reports whether a send failed because the nonce was already used.
+func isNonceError(err error) bool {
+	msg := err.Error()
+	for _, s := range []string{"nonce too low", "already known", "replacement transaction underpriced"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+// blobTxGas is the gas limit SendBlobTransaction sets for a blob tx to self.
+const blobTxGas = params.TxGas * 120 / 10
+
+// Sender is an account of a SenderPool and the blob txs it has in flight.
+type Sender struct {
+	Account *bb.AuthAcct
+	// Pending is the block each in-flight tx of the account was sent in, by tx hash.
+	Pending map[string]int64
+	// Bids is how many preconf bids were sent for each in-flight tx.
+	Bids map[string]int
+	// Txs is the signed tx of each hash in Pending, kept with its sidecar for replacements.
+	Txs map[string]*types.Transaction
+
+	lowBalance bool
+}
+
+// PendingCost returns the most the in-flight txs of the sender can cost together.
+func (s *Sender) PendingCost() *big.Int {
+	total := new(big.Int)
+	for txHash := range s.Pending {
+		if tx, ok := s.Txs[txHash]; ok {
+			total.Add(total, tx.Cost())
+		}
+	}
+	return total
+}
+
+// SenderPool rotates blob txs across several accounts, so more blob txs can be in flight at once than a single
+// account's nonce sequence allows to land. Each account keeps its own pending txs.
+type SenderPool struct {
+	mu      sync.Mutex
+	client  *ethclient.Client
+	senders []*Sender
+	next    int
+}
+
+// NewSenderPool creates a pool sending from accounts on the chain of client.
+func NewSenderPool(client *ethclient.Client, accounts []*bb.AuthAcct) (*SenderPool, error) {
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("sender pool needs at least one account")
+	}
+
+	p := &SenderPool{client: client}
+	seen := make(map[string]bool)
+	for _, acct := range accounts {
+		if seen[acct.Address.Hex()] {
+			return nil, fmt.Errorf("account %s is in the sender pool twice", acct.Address.Hex())
+		}
+		seen[acct.Address.Hex()] = true
+		p.senders = append(p.senders, &Sender{
+			Account: acct,
+			Pending: make(map[string]int64),
+			Bids:    make(map[string]int),
+			Txs:     make(map[string]*types.Transaction),
+		})
+	}
+	return p, nil
+}
+
+// Senders returns every account of the pool.
+func (p *SenderPool) Senders() []*Sender {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*Sender(nil), p.senders...)
+}
+
+// Next picks the next account in turn that has fewer than maxPending txs in flight and can pay for a blob tx of
+// numBlobs blobs at the current fees. It returns nil when no account can send right now.
+func (p *SenderPool) Next(ctx context.Context, maxPending, numBlobs int) (*Sender, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var cost *big.Int
+	for i := 0; i < len(p.senders); i++ {
+		s := p.senders[(p.next+i)%len(p.senders)]
+		if len(s.Pending) >= maxPending {
+			continue
+		}
+
+		if cost == nil {
+			var err error
+			if cost, err = EstimateBlobTxCost(p.client, numBlobs); err != nil {
+				return nil, err
+			}
+		}
+		// blob pool txs are not in the pending state, so the in-flight txs are taken off here
+		balance, err := p.client.BalanceAt(ctx, s.Account.Address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get balance of %s: %v", s.Account.Address.Hex(), err)
+		}
+		balance.Sub(balance, s.PendingCost())
+		if balance.Cmp(cost) < 0 {
+			if !s.lowBalance {
+				log.Warn("Sender balance too low for a blob tx, skipping it", "address", s.Account.Address, "balance", balance, "cost", cost)
+				s.lowBalance = true
+			}
+			continue
+		}
+		s.lowBalance = false
+
+		p.next = (p.next + i + 1) % len(p.senders)
+		return s, nil
+	}
+	return nil, nil
+}
+
+// EstimateBlobTxCost returns the most a blob tx of numBlobs blobs sent with SendBlobTransaction can cost at the
+// current fees: its gas limit at the fee cap plus the blob gas at the blob fee cap.
+func EstimateBlobTxCost(client *ethclient.Client, numBlobs int) (*big.Int, error) {
+	_, gasFeeCap, err := suggestGasTipAndFeeCap(client, context.Background())
+	if err != nil {
+		return nil, err
+	}
+	blobFee, err := NextBlobBaseFee(client)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(blobTxGas))
+	blobGas := new(big.Int).SetUint64(uint64(numBlobs) * params.BlobTxBlobGasPerBlob)
+	return cost.Add(cost, blobGas.Mul(blobGas, blobFee)), nil
+}
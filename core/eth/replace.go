@@ -0,0 +1,102 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/holiman/uint256"
+	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
+)
+
+// BlobPriceBump is the percentage every fee of a blob tx must be raised by to replace it. Geth's blob pool
+// requires 100%, twice the bump of the regular pool.
+const BlobPriceBump = 100
+
+// ReplaceBlobTransaction re-signs a pending blob tx sent with SendBlobTransaction with the same nonce, gas and
+// sidecar, and sends it the same way. The tip, fee cap and blob fee cap are bumped by BlobPriceBump, and the fee
+// caps are raised further to the current suggestion and blob base fee when those are higher. It returns the
+// replacement, which keeps the sidecar so it can be replaced again.
+func ReplaceBlobTransaction(client *ethclient.Client, rpcEndpoint string, private bool, authAcct bb.AuthAcct, tx *types.Transaction) (*types.Transaction, error) {
+	sidecar := tx.BlobTxSidecar()
+	if sidecar == nil {
+		return nil, fmt.Errorf("blob transaction %s has no sidecar to reuse", tx.Hash().Hex())
+	}
+
+	ctx := context.Background()
+
+	_, gasFeeCap, err := suggestGasTipAndFeeCap(client, ctx)
+	if err != nil {
+		return nil, err
+	}
+	blobFeeCap, err := NextBlobBaseFee(client)
+	if err != nil {
+		return nil, err
+	}
+
+	gasTipCap := bumpFee(tx.GasTipCap(), nil)
+	gasFeeCap = bumpFee(tx.GasFeeCap(), gasFeeCap)
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		gasFeeCap = gasTipCap
+	}
+
+	replacement := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.MustFromBig(tx.ChainId()),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  uint256.MustFromBig(gasTipCap),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+		Gas:        tx.Gas(),
+		To:         *tx.To(),
+		Value:      uint256.MustFromBig(tx.Value()),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+		BlobFeeCap: uint256.MustFromBig(bumpFee(tx.BlobGasFeeCap(), blobFeeCap)),
+		BlobHashes: tx.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+
+	signedTx, err := authAcct.Signer.SignTx(ctx, replacement, tx.ChainId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := broadcastBlobTx(ctx, client, rpcEndpoint, private, signedTx); err != nil {
+		return nil, fmt.Errorf("failed to send replacement of %s: %v", tx.Hash().Hex(), err)
+	}
+
+	transactionParameters := map[string]interface{}{
+		"hash":          signedTx.Hash().String(),
+		"replaces":      tx.Hash().String(),
+		"chainID":       signedTx.ChainId(),
+		"nonce":         signedTx.Nonce(),
+		"gasTipCap":     signedTx.GasTipCap(),
+		"gasFeeCap":     signedTx.GasFeeCap(),
+		"gasLimit":      signedTx.Gas(),
+		"to":            signedTx.To(),
+		"blobFeeCap":    signedTx.BlobGasFeeCap(),
+		"blobHashes":    signedTx.BlobHashes(),
+		"timeSubmitted": time.Now().UnixMilli(),
+		"numBlobs":      len(sidecar.Blobs),
+	}
+
+	go saveTransactionParameters("data/blobs.json", transactionParameters) // Asynchronous saving
+
+	return signedTx, nil
+}
+
+// bumpFee returns old raised by BlobPriceBump percent, or current if that is higher.
+func bumpFee(old, current *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(old, big.NewInt(100+BlobPriceBump))
+	bumped.Div(bumped, big.NewInt(100))
+	// a zero fee can't be bumped by a percentage
+	if bumped.Cmp(old) == 0 {
+		bumped.Add(bumped, big.NewInt(1))
+	}
+	if current != nil && current.Cmp(bumped) > 0 {
+		return new(big.Int).Set(current)
+	}
+	return bumped
+}
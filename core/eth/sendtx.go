@@ -3,7 +3,6 @@ package eth
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -18,26 +17,19 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 	bb "github.com/primev/preconf_blob_bidder/core/mevcommit"
 )
 
 // send an eth transfer to self. Only works with public RPC, doesn't work with titan custom endpoint.
 func SelfETHTransfer(client *ethclient.Client, authAcct bb.AuthAcct, value *big.Int, gasLimit uint64, data []byte) (string, error) {
-	// Get Address nonce
-	nonce, err := client.PendingNonceAt(context.Background(), authAcct.Address)
-	if err != nil {
-		return "", err
-	}
-
 	// Get base fee per gas
 	header, err := client.HeaderByNumber(context.Background(), nil)
 	if err != nil {
@@ -58,6 +50,13 @@ func SelfETHTransfer(client *ethclient.Client, authAcct bb.AuthAcct, value *big.
 	}
 	// chainID := big.NewInt(17000) // Holesky
 
+	// Get Address nonce
+	nonces := Nonces(client, authAcct.Address)
+	nonce, err := nonces.Next(context.Background())
+	if err != nil {
+		return "", err
+	}
+
 	// Create EIP-1559 transaction
 	tx := types.NewTx(&types.DynamicFeeTx{
 		Nonce:     nonce,
@@ -69,9 +68,9 @@ func SelfETHTransfer(client *ethclient.Client, authAcct bb.AuthAcct, value *big.
 		Data:      data,
 	})
 
-	signer := types.LatestSignerForChainID(chainID)
-	signedTx, err := types.SignTx(tx, signer, authAcct.PrivateKey)
+	signedTx, err := authAcct.Signer.SignTx(context.Background(), tx, chainID)
 	if err != nil {
+		nonces.Done(nonce, err)
 		return "", err
 	}
 
@@ -79,10 +78,12 @@ func SelfETHTransfer(client *ethclient.Client, authAcct bb.AuthAcct, value *big.
 	var buf bytes.Buffer
 	err = signedTx.EncodeRLP(&buf)
 	if err != nil {
+		nonces.Done(nonce, err)
 		return "", err
 	}
 
 	err = client.SendTransaction(context.Background(), signedTx)
+	nonces.Done(nonce, err)
 	if err != nil {
 		return "", err
 	}
@@ -92,37 +93,38 @@ func SelfETHTransfer(client *ethclient.Client, authAcct bb.AuthAcct, value *big.
 
 // sends a signed blob transaction to the network. Also sends to Titan endpoint on Holesky internally. 
 func ExecuteBlobTransaction(client *ethclient.Client, rpcEndpoint string, private bool, authAcct bb.AuthAcct, numBlobs int) (string, error) {
+	signedTx, err := SendBlobTransaction(client, rpcEndpoint, private, authAcct, RandomBlobs(numBlobs))
+	if err != nil {
+		return "", err
+	}
+	return signedTx.Hash().String(), nil
+}
+
+// SendBlobTransaction sends a signed blob transaction carrying blobs, see EncodeBlobs and RandomBlobs. The returned
+// transaction keeps its sidecar so it can be replaced with ReplaceBlobTransaction.
+func SendBlobTransaction(client *ethclient.Client, rpcEndpoint string, private bool, authAcct bb.AuthAcct, blobs []kzg4844.Blob) (*types.Transaction, error) {
+	if len(blobs) == 0 || len(blobs) > MaxBlobsPerTx {
+		return nil, fmt.Errorf("a blob transaction carries 1 to %d blobs, got %d", MaxBlobsPerTx, len(blobs))
+	}
+
 	glogger := log.NewGlogHandler(log.NewTerminalHandler(os.Stderr, true))
 	glogger.Verbosity(log.LevelInfo)
 	log.SetDefault(log.NewLogger(glogger))
 
-	privateKey := authAcct.PrivateKey
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return "", fmt.Errorf("failed to cast public key to ECDSA")
-	}
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
+	fromAddress := authAcct.Address
 
 	ctx := context.Background()
 
 	var (
-		chainID                *big.Int
-		nonce                  uint64
-		gasTipCap              *big.Int
-		gasFeeCap              *big.Int
-		parentHeader           *types.Header
-		err1, err2, err3, err4 error
+		chainID          *big.Int
+		gasTipCap        *big.Int
+		gasFeeCap        *big.Int
+		parentHeader     *types.Header
+		err1, err2, err3 error
 	)
 
-	// connect internally to titan holesky client on the side.
-	titan_client, err := bb.NewGethClient("http://holesky-rpc.titanbuilder.xyz/")
-	if err != nil {
-		fmt.Println("Failed to connect to titan client: ", err)
-	}
-
 	var wg sync.WaitGroup
-	wg.Add(4)
+	wg.Add(3)
 	// chainID = big.NewInt(17000) // Holesky
 	go func() {
 		defer wg.Done()
@@ -131,31 +133,23 @@ func ExecuteBlobTransaction(client *ethclient.Client, rpcEndpoint string, privat
 
 	go func() {
 		defer wg.Done()
-		nonce, err2 = client.PendingNonceAt(ctx, fromAddress)
+		gasTipCap, gasFeeCap, err2 = suggestGasTipAndFeeCap(client, ctx)
 	}()
 
 	go func() {
 		defer wg.Done()
-		gasTipCap, gasFeeCap, err3 = suggestGasTipAndFeeCap(client, ctx)
-	}()
-
-	go func() {
-		defer wg.Done()
-		parentHeader, err4 = client.HeaderByNumber(ctx, nil)
+		parentHeader, err3 = client.HeaderByNumber(ctx, nil)
 	}()
 
 	wg.Wait()
 	if err1 != nil {
-		return "", err1
+		return nil, err1
 	}
 	if err2 != nil {
-		return "", err2
+		return nil, err2
 	}
 	if err3 != nil {
-		return "", err3
-	}
-	if err4 != nil {
-		return "", err4
+		return nil, err3
 	}
 
 	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{
@@ -166,16 +160,22 @@ func ExecuteBlobTransaction(client *ethclient.Client, rpcEndpoint string, privat
 		Value:     big.NewInt(0),
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	parentExcessBlobGas := eip4844.CalcExcessBlobGas(*parentHeader.ExcessBlobGas, *parentHeader.BlobGasUsed)
 	blobFeeCap := eip4844.CalcBlobFee(parentExcessBlobGas)
 
-	blobs := randBlobs(numBlobs)
 	sideCar := makeSidecar(blobs)
 	blobHashes := sideCar.BlobHashes()
 
+	// the nonce is only taken once nothing but signing and sending is left
+	nonces := Nonces(client, fromAddress)
+	nonce, err := nonces.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	fixed_priority_fee := big.NewInt(1000000) // 0.001 gwei
 	tx := types.NewTx(&types.BlobTx{
 		ChainID:   uint256.MustFromBig(chainID),
@@ -190,32 +190,16 @@ func ExecuteBlobTransaction(client *ethclient.Client, rpcEndpoint string, privat
 		Sidecar:    sideCar,
 	})
 
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	signedTx, err := authAcct.Signer.SignTx(ctx, tx, chainID)
 	if err != nil {
-		return "", err
+		nonces.Done(nonce, err)
+		return nil, err
 	}
 
-	signedTx, err := auth.Signer(auth.From, tx)
+	err = broadcastBlobTx(ctx, client, rpcEndpoint, private, signedTx)
+	nonces.Done(nonce, err)
 	if err != nil {
-		return "", err
-	}
-
-	if private {
-		err = sendPrivateRawTransaction(rpcEndpoint, signedTx)
-		if err != nil {
-			return "", err
-		}
-	} else {
-		// send transaction by the endpoint argument + titan rpc
-		err = client.SendTransaction(ctx, signedTx)
-		if err != nil {
-			return "", err
-		}
-
-		err = titan_client.SendTransaction(ctx, signedTx)
-		if err != nil {
-			return "", err
-		}
+		return nil, err
 	}
 
 	currentTimeMillis := time.Now().UnixNano() / int64(time.Millisecond)
@@ -231,12 +215,68 @@ func ExecuteBlobTransaction(client *ethclient.Client, rpcEndpoint string, privat
 		"blobFeeCap":    signedTx.BlobGasFeeCap(),
 		"blobHashes":    signedTx.BlobHashes(),
 		"timeSubmitted": currentTimeMillis,
-		"numBlobs":      numBlobs,
+		"numBlobs":      len(blobs),
 	}
 
 	go saveTransactionParameters("data/blobs.json", transactionParameters) // Asynchronous saving
 
-	return signedTx.Hash().String(), nil
+	return signedTx, nil
+}
+
+// broadcastBlobTx sends signedTx privately through rpcEndpoint, or publicly to client. Public holesky txs are
+// also sent to the Titan Holesky endpoint, on a best-effort basis: once client accepted the tx, it is sent.
+func broadcastBlobTx(ctx context.Context, client *ethclient.Client, rpcEndpoint string, private bool, signedTx *types.Transaction) error {
+	if private {
+		return sendPrivateRawTransaction(rpcEndpoint, signedTx)
+	}
+
+	if err := client.SendTransaction(ctx, signedTx); err != nil {
+		return err
+	}
+
+	if signedTx.ChainId().Cmp(params.HoleskyChainConfig.ChainID) == 0 {
+		if titan := titanClient(); titan != nil {
+			if err := titan.SendTransaction(ctx, signedTx); err != nil {
+				log.Warn("Failed to send tx to Titan", "tx", signedTx.Hash(), "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// titanHoleskyRPC is Titan's Holesky builder endpoint.
+const titanHoleskyRPC = "http://holesky-rpc.titanbuilder.xyz/"
+
+var (
+	titanOnce   sync.Once
+	titanShared *ethclient.Client
+)
+
+// titanClient returns the shared Titan Holesky client, or nil if it can't be created.
+func titanClient() *ethclient.Client {
+	titanOnce.Do(func() {
+		client, err := bb.NewGethClient(titanHoleskyRPC)
+		if err != nil {
+			log.Warn("Failed to connect to Titan", "error", err)
+			return
+		}
+		titanShared = client
+	})
+	return titanShared
+}
+
+// NextBlobBaseFee returns the blob base fee in wei for the block following the latest header.
+func NextBlobBaseFee(client *ethclient.Client) (*big.Int, error) {
+	header, err := client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if header.ExcessBlobGas == nil || header.BlobGasUsed == nil {
+		return nil, fmt.Errorf("header %d has no blob gas fields", header.Number)
+	}
+
+	excessBlobGas := eip4844.CalcExcessBlobGas(*header.ExcessBlobGas, *header.BlobGasUsed)
+	return eip4844.CalcBlobFee(excessBlobGas), nil
 }
 
 func suggestGasTipAndFeeCap(client *ethclient.Client, ctx context.Context) (*big.Int, *big.Int, error) {
@@ -370,7 +410,8 @@ func makeSidecar(blobs []kzg4844.Blob) *types.BlobTxSidecar {
 	}
 }
 
-func randBlobs(n int) []kzg4844.Blob {
+// RandomBlobs returns n blobs of random field elements.
+func RandomBlobs(n int) []kzg4844.Blob {
 	blobs := make([]kzg4844.Blob, n)
 	for i := 0; i < n; i++ {
 		blobs[i] = randBlob()
@@ -3,16 +3,46 @@ package mevcommit
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
 	pb "github.com/primev/preconf_blob_bidder/core/bidderpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// WindowDeposit is the amount in wei a bidder holds in a bidding window.
+type WindowDeposit struct {
+	Window uint64
+	Amount *big.Int
+}
+
+// AutoDepositResult describes the auto deposit started by Bidder.AutoDeposit.
+type AutoDepositResult struct {
+	StartWindow     uint64
+	AmountPerWindow *big.Int
+}
+
+// AutoDepositWindow is the balance of a single window managed by auto deposit.
+type AutoDepositWindow struct {
+	Window     uint64
+	Amount     *big.Int
+	IsCurrent  bool
+	StartBlock uint64
+	EndBlock   uint64
+}
+
+// AutoDepositStatus reports whether auto deposit is enabled and the windows it currently funds.
+type AutoDepositStatus struct {
+	Enabled bool
+	Windows []AutoDepositWindow
+}
+
 func (b *Bidder) SendBid(txHashes []string, amount string, blockNumber, decayStart, decayEnd int64) (pb.Bidder_SendBidClient, error) {
 	glogger := log.NewGlogHandler(log.NewTerminalHandler(os.Stderr, true))
 	glogger.Verbosity(log.LevelInfo)
@@ -68,6 +98,57 @@ func (b *Bidder) SendBid(txHashes []string, amount string, blockNumber, decaySta
 	return response, nil
 }
 
+// ErrBidNotSent is returned by SendBidWithContext when the bid never reached the bidder node.
+var ErrBidNotSent = errors.New("bid not sent")
+
+// SendBidWithContext sends a bid and calls onCommitment for each commitment as soon as the bidder node
+// streams it, instead of waiting for every provider to answer. The wait is bounded by ctx, so callers
+// typically set a deadline at the bid's decay end. The commitments received so far are always returned,
+// together with the error that ended the stream early, if any. An error wrapping ErrBidNotSent means the
+// bid was not sent at all. onCommitment may be nil.
+func (b *Bidder) SendBidWithContext(ctx context.Context, txHashes []string, amount string, blockNumber, decayStart, decayEnd int64, onCommitment func(*pb.Commitment)) ([]*pb.Commitment, error) {
+	bidRequest := &pb.Bid{
+		TxHashes:            txHashes,
+		Amount:              amount,
+		BlockNumber:         blockNumber,
+		DecayStartTimestamp: decayStart,
+		DecayEndTimestamp:   decayEnd,
+	}
+
+	response, err := b.client.SendBid(ctx, bidRequest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBidNotSent, err)
+	}
+	saveBidRequest("data/bid.json", bidRequest, time.Now().Unix())
+
+	var commitments []*pb.Commitment
+	defer func() {
+		if len(commitments) == 0 {
+			return
+		}
+		responses := make([]interface{}, len(commitments))
+		for i, c := range commitments {
+			responses[i] = c
+		}
+		saveBidResponses("data/response.json", responses)
+	}()
+
+	for {
+		msg, err := response.Recv()
+		if err == io.EOF {
+			return commitments, nil
+		}
+		if err != nil {
+			return commitments, fmt.Errorf("failed to receive commitment after %d commitments: %w", len(commitments), err)
+		}
+
+		commitments = append(commitments, msg)
+		if onCommitment != nil {
+			onCommitment(msg)
+		}
+	}
+}
+
 // saveBidRequest saves bid request and timestamp to a JSON file
 func saveBidRequest(filename string, bidRequest *pb.Bid, timestamp int64) {
 	// Ensure the directory exists
@@ -144,3 +225,140 @@ func saveBidResponses(filename string, responses []interface{}) {
 		log.Error("Failed to encode data to JSON", "error", err)
 	}
 }
+
+// Deposit deposits amount wei into the given window. A window of 0 lets the bidder node use the current window.
+func (b *Bidder) Deposit(ctx context.Context, amount *big.Int, window uint64) (*WindowDeposit, error) {
+	req := &pb.DepositRequest{Amount: amount.String()}
+	if window != 0 {
+		req.WindowNumber = wrapperspb.UInt64(window)
+	}
+
+	resp, err := b.client.Deposit(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", err)
+	}
+	return parseWindowDeposit(resp.GetAmount(), resp.GetWindowNumber())
+}
+
+// AutoDeposit deposits amountPerWindow wei into the current window and keeps moving the deposit
+// forward into each new window until CancelAutoDeposit is called.
+func (b *Bidder) AutoDeposit(ctx context.Context, amountPerWindow *big.Int) (*AutoDepositResult, error) {
+	resp, err := b.client.AutoDeposit(ctx, &pb.DepositRequest{Amount: amountPerWindow.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start auto deposit: %w", err)
+	}
+
+	amount, err := parseAmount(resp.GetAmountPerWindow())
+	if err != nil {
+		return nil, err
+	}
+	return &AutoDepositResult{
+		StartWindow:     resp.GetStartWindowNumber().GetValue(),
+		AmountPerWindow: amount,
+	}, nil
+}
+
+// CancelAutoDeposit stops auto deposit. If withdraw is set, the node also withdraws the deposits of
+// past windows. Returns the windows that were withdrawn.
+func (b *Bidder) CancelAutoDeposit(ctx context.Context, withdraw bool) ([]uint64, error) {
+	resp, err := b.client.CancelAutoDeposit(ctx, &pb.CancelAutoDepositRequest{Withdraw: withdraw})
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel auto deposit: %w", err)
+	}
+
+	windows := make([]uint64, 0, len(resp.GetWindowNumbers()))
+	for _, w := range resp.GetWindowNumbers() {
+		windows = append(windows, w.GetValue())
+	}
+	return windows, nil
+}
+
+// AutoDepositStatus returns whether auto deposit is enabled and the balance of each window it funds.
+func (b *Bidder) AutoDepositStatus(ctx context.Context) (*AutoDepositStatus, error) {
+	resp, err := b.client.AutoDepositStatus(ctx, &pb.EmptyMessage{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auto deposit status: %w", err)
+	}
+
+	status := &AutoDepositStatus{Enabled: resp.GetIsAutodepositEnabled()}
+	for _, wb := range resp.GetWindowBalances() {
+		amount, err := parseAmount(wb.GetDepositedAmount())
+		if err != nil {
+			return nil, err
+		}
+		status.Windows = append(status.Windows, AutoDepositWindow{
+			Window:     wb.GetWindowNumber().GetValue(),
+			Amount:     amount,
+			IsCurrent:  wb.GetIsCurrent(),
+			StartBlock: wb.GetStartBlockNumber().GetValue(),
+			EndBlock:   wb.GetEndBlockNumber().GetValue(),
+		})
+	}
+	return status, nil
+}
+
+// GetDeposit returns the bidder's deposit in the given window. A window of 0 means the current window.
+func (b *Bidder) GetDeposit(ctx context.Context, window uint64) (*WindowDeposit, error) {
+	req := &pb.GetDepositRequest{}
+	if window != 0 {
+		req.WindowNumber = wrapperspb.UInt64(window)
+	}
+
+	resp, err := b.client.GetDeposit(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deposit: %w", err)
+	}
+	return parseWindowDeposit(resp.GetAmount(), resp.GetWindowNumber())
+}
+
+// Withdraw withdraws the bidder's deposit from the given window.
+func (b *Bidder) Withdraw(ctx context.Context, window uint64) (*WindowDeposit, error) {
+	resp, err := b.client.Withdraw(ctx, &pb.WithdrawRequest{WindowNumber: wrapperspb.UInt64(window)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw from window %d: %w", window, err)
+	}
+	return parseWindowDeposit(resp.GetAmount(), resp.GetWindowNumber())
+}
+
+// WithdrawFromWindows withdraws the bidder's deposits from several windows in one call.
+func (b *Bidder) WithdrawFromWindows(ctx context.Context, windows []uint64) ([]WindowDeposit, error) {
+	req := &pb.WithdrawFromWindowsRequest{}
+	for _, w := range windows {
+		req.WindowNumbers = append(req.WindowNumbers, wrapperspb.UInt64(w))
+	}
+
+	resp, err := b.client.WithdrawFromWindows(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw from windows: %w", err)
+	}
+
+	withdrawals := make([]WindowDeposit, 0, len(resp.GetWithdrawResponses()))
+	for _, wr := range resp.GetWithdrawResponses() {
+		withdrawal, err := parseWindowDeposit(wr.GetAmount(), wr.GetWindowNumber())
+		if err != nil {
+			return nil, err
+		}
+		withdrawals = append(withdrawals, *withdrawal)
+	}
+	return withdrawals, nil
+}
+
+func parseWindowDeposit(amount string, window *wrapperspb.UInt64Value) (*WindowDeposit, error) {
+	value, err := parseAmount(amount)
+	if err != nil {
+		return nil, err
+	}
+	return &WindowDeposit{Window: window.GetValue(), Amount: value}, nil
+}
+
+// parseAmount converts a wei amount returned by the bidder node into a *big.Int. An empty amount is zero.
+func parseAmount(amount string) (*big.Int, error) {
+	if amount == "" {
+		return new(big.Int), nil
+	}
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q returned by bidder node", amount)
+	}
+	return value, nil
+}
@@ -0,0 +1,91 @@
+package mevcommit
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/preconf_blob_bidder/core/contracts/bidderregistry"
+	"github.com/primev/preconf_blob_bidder/core/contracts/blocktracker"
+	"github.com/primev/preconf_blob_bidder/core/contracts/l1gateway"
+	"github.com/primev/preconf_blob_bidder/core/contracts/oracle"
+	"github.com/primev/preconf_blob_bidder/core/contracts/preconfcommitmentstore"
+	"github.com/primev/preconf_blob_bidder/core/contracts/providerregistry"
+	"github.com/primev/preconf_blob_bidder/core/contracts/settlementgateway"
+	"github.com/primev/preconf_blob_bidder/core/contracts/validatorregistry"
+)
+
+// contractKey identifies a typed contract client by backend, contract type and address.
+type contractKey struct {
+	backend bind.ContractBackend
+	name    string
+	address common.Address
+}
+
+var (
+	contractsMu sync.Mutex
+	contracts   = make(map[contractKey]interface{})
+)
+
+// cachedContract returns the typed client for the named contract of the current network, creating it on first use.
+func cachedContract[T any](backend bind.ContractBackend, name string, address common.Address, newContract func(common.Address, bind.ContractBackend) (*T, error)) (*T, error) {
+	address, err := contractAddress(name, address)
+	if err != nil {
+		return nil, err
+	}
+
+	contractsMu.Lock()
+	defer contractsMu.Unlock()
+
+	key := contractKey{backend: backend, name: name, address: address}
+	if c, ok := contracts[key]; ok {
+		return c.(*T), nil
+	}
+
+	c, err := newContract(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	contracts[key] = c
+	return c, nil
+}
+
+// BidderRegistryContract returns the BidderRegistry client of the current network on the mev-commit chain.
+func BidderRegistryContract(client bind.ContractBackend) (*bidderregistry.BidderRegistry, error) {
+	return cachedContract(client, "BidderRegistry", currentNetwork.Contracts.BidderRegistry, bidderregistry.NewBidderRegistry)
+}
+
+// BlockTrackerContract returns the BlockTracker client of the current network on the mev-commit chain.
+func BlockTrackerContract(client bind.ContractBackend) (*blocktracker.BlockTracker, error) {
+	return cachedContract(client, "BlockTracker", currentNetwork.Contracts.BlockTracker, blocktracker.NewBlockTracker)
+}
+
+// PreConfCommitmentStoreContract returns the PreConfCommitmentStore client of the current network on the mev-commit chain.
+func PreConfCommitmentStoreContract(client bind.ContractBackend) (*preconfcommitmentstore.PreConfCommitmentStore, error) {
+	return cachedContract(client, "PreConfCommitmentStore", currentNetwork.Contracts.PreConfCommitmentStore, preconfcommitmentstore.NewPreConfCommitmentStore)
+}
+
+// OracleContract returns the Oracle client of the current network on the mev-commit chain.
+func OracleContract(client bind.ContractBackend) (*oracle.Oracle, error) {
+	return cachedContract(client, "Oracle", currentNetwork.Contracts.Oracle, oracle.NewOracle)
+}
+
+// ProviderRegistryContract returns the ProviderRegistry client of the current network on the mev-commit chain.
+func ProviderRegistryContract(client bind.ContractBackend) (*providerregistry.ProviderRegistry, error) {
+	return cachedContract(client, "ProviderRegistry", currentNetwork.Contracts.ProviderRegistry, providerregistry.NewProviderRegistry)
+}
+
+// SettlementGatewayContract returns the SettlementGateway client of the current network on the mev-commit chain.
+func SettlementGatewayContract(client bind.ContractBackend) (*settlementgateway.SettlementGateway, error) {
+	return cachedContract(client, "SettlementGateway", currentNetwork.Contracts.SettlementGateway, settlementgateway.NewSettlementGateway)
+}
+
+// L1GatewayContract returns the L1Gateway client of the current network. client must be connected to L1.
+func L1GatewayContract(client bind.ContractBackend) (*l1gateway.L1Gateway, error) {
+	return cachedContract(client, "L1Gateway", currentNetwork.Contracts.L1Gateway, l1gateway.NewL1Gateway)
+}
+
+// ValidatorRegistryContract returns the ValidatorRegistry client of the current network. client must be connected to L1.
+func ValidatorRegistryContract(client bind.ContractBackend) (*validatorregistry.ValidatorRegistry, error) {
+	return cachedContract(client, "ValidatorRegistry", currentNetwork.Contracts.ValidatorRegistry, validatorregistry.NewValidatorRegistry)
+}
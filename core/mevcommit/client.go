@@ -1,7 +1,7 @@
 package mevcommit
 
 import (
-	"crypto/ecdsa"
+	"context"
 	"fmt"
 	"log"
 	"math/big"
@@ -9,7 +9,7 @@ import (
 	pb "github.com/primev/preconf_blob_bidder/core/bidderpb"
 	"google.golang.org/grpc"
 
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -30,17 +30,19 @@ type Bidder struct {
 	client pb.BidderClient
 }
 
-// GethConfig holds configuration settings for a geth node to connect to mev-commit chain.
+// GethConfig holds the node endpoints of the two chains. An empty endpoint falls back to the network's RPC.
 type GethConfig struct {
-	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	L1Endpoint        string `json:"l1_endpoint" yaml:"l1_endpoint"`
+	MevCommitEndpoint string `json:"mev_commit_endpoint" yaml:"mev_commit_endpoint"`
 }
 
-// AuthAcct holds the private key, public key, address, and authentication for a given private key.
+// AuthAcct holds the signer, address, chain ID and transact options of an account. Transactions are always signed
+// through Signer, so the key itself may live in a keystore file or a remote signer.
 type AuthAcct struct {
-	PrivateKey *ecdsa.PrivateKey
-	PublicKey  *ecdsa.PublicKey
-	Address    common.Address
-	Auth       *bind.TransactOpts
+	Signer  Signer
+	Address common.Address
+	ChainID *big.Int
+	Auth    *bind.TransactOpts
 }
 
 // NewBidderClient creates a new gRPC client connection to the bidder service and returns a bidder instance.
@@ -68,42 +70,46 @@ func NewGethClient(endpoint string) (*ethclient.Client, error) {
 	return ec, nil
 }
 
-// AuthenticateAddress converts a hex-encoded private key string to a AuthAcct struct.
-func AuthenticateAddress(privateKeyHex string, client *ethclient.Client) (*AuthAcct, error) {
+// AuthenticateAddress converts a hex-encoded private key string to a AuthAcct struct that signs for chainID.
+// If chainID is nil, it is read from the client.
+func AuthenticateAddress(privateKeyHex string, client *ethclient.Client, chainID *big.Int) (*AuthAcct, error) {
 	if privateKeyHex == "" {
 		return nil, nil
 	}
 
-	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	signer, err := NewKeySigner(privateKeyHex)
 	if err != nil {
 		log.Printf("Failed to load private key: %v", err)
 		return nil, err
 	}
+	return Authenticate(signer, client, chainID)
+}
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("Failed to assert public key type")
-	}
-
-	address := crypto.PubkeyToAddress(*publicKeyECDSA)
-
-	// chainID, err := client.NetworkID(context.Background())
-	// if err != nil {
-	// 	log.Fatalf("Failed to get chain ID: %v", err)
-	// }
-
-	chainID := big.NewInt(17000) // Holesky
-
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
-	if err != nil {
-		log.Fatalf("Failed to create authorized transactor: %v", err)
+// Authenticate returns the AuthAcct of signer, with transact options that sign through it for chainID.
+// If chainID is nil, it is read from the client.
+func Authenticate(signer Signer, client *ethclient.Client, chainID *big.Int) (*AuthAcct, error) {
+	if chainID == nil {
+		var err error
+		chainID, err = client.ChainID(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		}
 	}
 
+	address := signer.Address()
 	return &AuthAcct{
-		PrivateKey: privateKey,
-		PublicKey:  publicKeyECDSA,
-		Address:    address,
-		Auth:       auth,
+		Address: address,
+		Signer:  signer,
+		ChainID: chainID,
+		Auth: &bind.TransactOpts{
+			From: address,
+			Signer: func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				if from != address {
+					return nil, bind.ErrNotAuthorized
+				}
+				return signer.SignTx(context.Background(), tx, chainID)
+			},
+			Context: context.Background(),
+		},
 	}, nil
 }
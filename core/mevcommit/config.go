@@ -0,0 +1,251 @@
+package mevcommit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables that override values loaded from the config file.
+const (
+	EnvProfile           = "PRECONF_PROFILE"
+	EnvNetwork           = "PRECONF_NETWORK"
+	EnvServerAddress     = "PRECONF_SERVER_ADDRESS"
+	EnvLogFmt            = "PRECONF_LOG_FMT"
+	EnvLogLevel          = "PRECONF_LOG_LEVEL"
+	EnvL1Endpoint        = "PRECONF_L1_ENDPOINT"
+	EnvMevCommitEndpoint = "PRECONF_MEV_COMMIT_ENDPOINT"
+	EnvPrivateKey        = "PRECONF_PRIVATE_KEY"
+	EnvKeystore          = "PRECONF_KEYSTORE"
+	EnvPasswordFile      = "PRECONF_KEYSTORE_PASSWORD_FILE"
+	EnvRemoteSigner      = "PRECONF_REMOTE_SIGNER"
+	EnvSignerAddress     = "PRECONF_SIGNER_ADDRESS"
+)
+
+// Config holds the settings of a single profile.
+type Config struct {
+	// Network is the name of a preset or of an entry in the config file's networks.
+	Network string       `json:"network" yaml:"network"`
+	Bidder  BidderConfig `json:"bidder" yaml:"bidder"`
+	Geth    GethConfig   `json:"geth" yaml:"geth"`
+	// PrivateKey is never read from the config file so that configs can be committed. Set it with
+	// the PRECONF_PRIVATE_KEY environment variable or the -privatekey flag.
+	PrivateKey string `json:"-" yaml:"-"`
+	// Signer selects a keystore file or a remote signer instead of PrivateKey.
+	Signer SignerConfig `json:"signer" yaml:"signer"`
+}
+
+// SignerConfig selects where transactions are signed. Without a keystore or a remote signer the private key
+// is used.
+type SignerConfig struct {
+	// Keystore is an encrypted geth keystore file. Its password is read from PasswordFile, or prompted for.
+	Keystore     string `json:"keystore" yaml:"keystore"`
+	PasswordFile string `json:"password_file" yaml:"password_file"`
+	// RemoteURL is the JSON-RPC endpoint of a web3signer or clef instance that signs for Address.
+	RemoteURL string `json:"remote_url" yaml:"remote_url"`
+	// RemoteMethod is RemoteSignerWeb3Signer, the default, or RemoteSignerClef.
+	RemoteMethod string `json:"remote_method" yaml:"remote_method"`
+	Address      string `json:"address" yaml:"address"`
+}
+
+// ConfigFile is the layout of a config file: a set of named profiles, the profile used when none is
+// selected and custom networks that extend or replace the presets.
+type ConfigFile struct {
+	DefaultProfile string             `json:"default_profile" yaml:"default_profile"`
+	Profiles       map[string]Config  `json:"profiles" yaml:"profiles"`
+	Networks       map[string]Network `json:"networks" yaml:"networks"`
+}
+
+// DefaultConfig returns the settings used when no config file is given.
+func DefaultConfig() Config {
+	return Config{
+		Network: DefaultNetwork,
+		Bidder: BidderConfig{
+			ServerAddress: "127.0.0.1:13524",
+			LogFmt:        "json",
+			LogLevel:      "info",
+		},
+	}
+}
+
+// LoadConfig reads the JSON or YAML config file at path, selects the named profile and applies environment
+// variable overrides. The profile falls back to PRECONF_PROFILE and then to the file's default_profile. An
+// empty path skips the file and starts from DefaultConfig. The result is not validated: callers apply their
+// own overrides first and then call Validate.
+func LoadConfig(path, profile string) (*Config, error) {
+	cfg := DefaultConfig()
+
+	if path != "" {
+		file, err := readConfigFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, n := range file.Networks {
+			if n.Name == "" {
+				n.Name = name
+			}
+			if err := RegisterNetwork(n); err != nil {
+				return nil, fmt.Errorf("invalid network %q in %s: %w", name, path, err)
+			}
+		}
+
+		if profile == "" {
+			profile = os.Getenv(EnvProfile)
+		}
+		if profile == "" {
+			profile = file.DefaultProfile
+		}
+		if profile == "" {
+			return nil, fmt.Errorf("no profile selected and %s has no default_profile", path)
+		}
+
+		selected, ok := file.Profiles[profile]
+		if !ok {
+			return nil, fmt.Errorf("profile %q not found in %s", profile, path)
+		}
+		cfg.merge(selected)
+	}
+
+	cfg.ApplyEnv()
+	return &cfg, nil
+}
+
+func readConfigFile(path string) (*ConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var file ConfigFile
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		err = json.Unmarshal(data, &file)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &file)
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q, use .json, .yaml or .yml", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return &file, nil
+}
+
+// merge copies the non-empty values of other into c.
+func (c *Config) merge(other Config) {
+	setIfNotEmpty(&c.Network, other.Network)
+	setIfNotEmpty(&c.Bidder.ServerAddress, other.Bidder.ServerAddress)
+	setIfNotEmpty(&c.Bidder.LogFmt, other.Bidder.LogFmt)
+	setIfNotEmpty(&c.Bidder.LogLevel, other.Bidder.LogLevel)
+	setIfNotEmpty(&c.Geth.L1Endpoint, other.Geth.L1Endpoint)
+	setIfNotEmpty(&c.Geth.MevCommitEndpoint, other.Geth.MevCommitEndpoint)
+	setIfNotEmpty(&c.Signer.Keystore, other.Signer.Keystore)
+	setIfNotEmpty(&c.Signer.PasswordFile, other.Signer.PasswordFile)
+	setIfNotEmpty(&c.Signer.RemoteURL, other.Signer.RemoteURL)
+	setIfNotEmpty(&c.Signer.RemoteMethod, other.Signer.RemoteMethod)
+	setIfNotEmpty(&c.Signer.Address, other.Signer.Address)
+}
+
+// ApplyEnv overrides c with any PRECONF_* environment variables that are set.
+func (c *Config) ApplyEnv() {
+	setIfNotEmpty(&c.Network, os.Getenv(EnvNetwork))
+	setIfNotEmpty(&c.Bidder.ServerAddress, os.Getenv(EnvServerAddress))
+	setIfNotEmpty(&c.Bidder.LogFmt, os.Getenv(EnvLogFmt))
+	setIfNotEmpty(&c.Bidder.LogLevel, os.Getenv(EnvLogLevel))
+	setIfNotEmpty(&c.Geth.L1Endpoint, os.Getenv(EnvL1Endpoint))
+	setIfNotEmpty(&c.Geth.MevCommitEndpoint, os.Getenv(EnvMevCommitEndpoint))
+	setIfNotEmpty(&c.PrivateKey, os.Getenv(EnvPrivateKey))
+	setIfNotEmpty(&c.Signer.Keystore, os.Getenv(EnvKeystore))
+	setIfNotEmpty(&c.Signer.PasswordFile, os.Getenv(EnvPasswordFile))
+	setIfNotEmpty(&c.Signer.RemoteURL, os.Getenv(EnvRemoteSigner))
+	setIfNotEmpty(&c.Signer.Address, os.Getenv(EnvSignerAddress))
+}
+
+// Validate checks that the values that are set are well formed. Whether a value is required
+// depends on the command, so empty endpoints and keys are accepted.
+func (c *Config) Validate() error {
+	if _, err := LookupNetwork(c.Network); err != nil {
+		return err
+	}
+
+	if _, _, err := net.SplitHostPort(c.Bidder.ServerAddress); err != nil {
+		return fmt.Errorf("invalid bidder server_address %q: %v", c.Bidder.ServerAddress, err)
+	}
+
+	switch c.Bidder.LogFmt {
+	case "json", "text":
+	default:
+		return fmt.Errorf("invalid bidder log_fmt %q, use json or text", c.Bidder.LogFmt)
+	}
+
+	switch c.Bidder.LogLevel {
+	case "debug", "info", "warn", "error":
+	default:
+		return fmt.Errorf("invalid bidder log_level %q, use debug, info, warn or error", c.Bidder.LogLevel)
+	}
+
+	if err := validateEndpoint("l1_endpoint", c.Geth.L1Endpoint); err != nil {
+		return err
+	}
+	if err := validateEndpoint("mev_commit_endpoint", c.Geth.MevCommitEndpoint); err != nil {
+		return err
+	}
+
+	return c.Signer.validate()
+}
+
+// validateEndpoint checks that a non-empty geth endpoint is an http(s) or ws(s) URL or an IPC path.
+func validateEndpoint(name, endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid geth %s %q: %v", name, endpoint, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+	case "":
+		// IPC socket path
+	default:
+		return fmt.Errorf("invalid geth %s %q, use an http(s) or ws(s) URL or an IPC path", name, endpoint)
+	}
+	return nil
+}
+
+func (c *SignerConfig) validate() error {
+	if c.Keystore != "" && c.RemoteURL != "" {
+		return fmt.Errorf("set either a signer keystore or a remote_url, not both")
+	}
+	if c.RemoteURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(c.RemoteURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid signer remote_url %q, use an http(s) URL", c.RemoteURL)
+	}
+	switch c.RemoteMethod {
+	case "", RemoteSignerWeb3Signer, RemoteSignerClef:
+	default:
+		return fmt.Errorf("invalid signer remote_method %q, use %s or %s", c.RemoteMethod, RemoteSignerWeb3Signer, RemoteSignerClef)
+	}
+	if !common.IsHexAddress(c.Address) {
+		return fmt.Errorf("invalid signer address %q, the remote signer needs the address to sign for", c.Address)
+	}
+	return nil
+}
+
+func setIfNotEmpty(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
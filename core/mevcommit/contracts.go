@@ -3,25 +3,20 @@ package mevcommit
 import (
 	"context"
 	"fmt"
-	"log"
 	"math/big"
-	"os"
+	"path/filepath"
 	"strings"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	abis "github.com/primev/preconf_blob_bidder/abi"
 )
 
-// contract addresses
-const bidderRegistryAddress = "0x7ffa86fF89489Bca72Fec2a978e33f9870B2Bd25"
-const blockTrackerAddress = "0x2eEbF31f5c932D51556E70235FB98bB2237d065c"
-const preConfCommitmentStoreAddress = "0xCAC68D97a56b19204Dd3dbDC103CB24D47A825A3"
-
-// CommitmentStoredEvent represents the data structure for the CommitmentStored event
+// CommitmentStoredEvent represents the data structure for the CommitmentStored event.
+// Raw is the log the event was decoded from; Raw.Removed is set when a reorg removed it.
 type CommitmentStoredEvent struct {
 	CommitmentIndex     [32]byte
 	Bidder              common.Address
@@ -37,93 +32,64 @@ type CommitmentStoredEvent struct {
 	CommitmentSignature []byte
 	DispatchTimestamp   uint64
 	SharedSecretKey     []byte
+	Raw                 types.Log
 }
 
-// LoadABI loads the ABI from the specified file path and parses it
+// LoadABI returns the parsed ABI of the contract named by filePath, e.g. "abi/BlockTracker.abi".
+// The ABIs are embedded in the binary, so only the file name is used and the working directory doesn't matter.
 func LoadABI(filePath string) (abi.ABI, error) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		log.Println("Failed to load ABI file:", err)
-	}
-
-	parsedABI, err := abi.JSON(strings.NewReader(string(data)))
-	if err != nil {
-		log.Println("Failed to load ABI file:", err)
-	}
-
-	return parsedABI, nil
+	return abis.Parsed(strings.TrimSuffix(filepath.Base(filePath), ".abi"))
 }
 
 // get latest window height
 func WindowHeight(client *ethclient.Client) (*big.Int, error) {
-	// Load blockTracker contract
-	blockTrackerABI, err := LoadABI("abi/BlockTracker.abi")
+	blockTracker, err := BlockTrackerContract(client)
 	if err != nil {
-		log.Println("Failed to load ABI file:", err)
+		return nil, err
 	}
 
-	blockTrackerContract := bind.NewBoundContract(common.HexToAddress(blockTrackerAddress), blockTrackerABI, client, client, client)
-
 	// Get current bidding window
-	var currentWindowResult []interface{}
-	err = blockTrackerContract.Call(nil, &currentWindowResult, "getCurrentWindow")
+	currentWindow, err := blockTracker.GetCurrentWindow(nil)
 	if err != nil {
-		log.Println(err)
-	}
-
-	// Extract the current window as *big.Int
-	currentWindow, ok := currentWindowResult[0].(*big.Int)
-	if !ok {
-		log.Println("Could not get current window", err)
+		return nil, fmt.Errorf("failed to call getCurrentWindow function: %v", err)
 	}
 
 	return currentWindow, nil
 }
 
 func GetMinDeposit(client *ethclient.Client) (*big.Int, error) {
-	bidderRegistryABI, err := LoadABI("abi/BidderRegistry.abi")
+	bidderRegistry, err := BidderRegistryContract(client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load ABI file: %v", err)
+		return nil, err
 	}
 
-	bidderRegistryContract := bind.NewBoundContract(common.HexToAddress(bidderRegistryAddress), bidderRegistryABI, client, client, client)
-
 	// Call the minDeposit function
-	var minDepositResult []interface{}
-	err = bidderRegistryContract.Call(nil, &minDepositResult, "minDeposit")
+	minDeposit, err := bidderRegistry.MinDeposit(nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call minDeposit function: %v", err)
 	}
 
-	// Extract the minDeposit as *big.Int
-	minDeposit, ok := minDepositResult[0].(*big.Int)
-	if !ok {
-		return nil, fmt.Errorf("failed to convert minDeposit to *big.Int")
-	}
-
 	return minDeposit, nil
 }
 
 // Deposit minimum bid amount into the bidding window. Returns a geth Transaction type if successful.
 func DepositIntoWindow(client *ethclient.Client, depositWindow *big.Int, authAcct *AuthAcct) (*types.Transaction, error) {
-	// Load bidderRegistry contract
-	bidderRegistryABI, err := LoadABI("abi/BidderRegistry.abi")
+	bidderRegistry, err := BidderRegistryContract(client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load ABI file: %v", err)
+		return nil, err
 	}
 
-	bidderRegistryContract := bind.NewBoundContract(common.HexToAddress(bidderRegistryAddress), bidderRegistryABI, client, client, client)
-
 	minDeposit, err := GetMinDeposit(client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get minDeposit: %v", err)
 	}
 
-	// Set the value to minDeposit
-	authAcct.Auth.Value = minDeposit
+	// Set the value to minDeposit without leaving it on the shared transactor
+	opts := *authAcct.Auth
+	opts.Value = minDeposit
 
 	// Prepare the transaction
-	tx, err := bidderRegistryContract.Transact(authAcct.Auth, "depositForSpecificWindow", depositWindow)
+	tx, err := bidderRegistry.DepositForSpecificWindow(&opts, depositWindow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %v", err)
 	}
@@ -144,47 +110,37 @@ func DepositIntoWindow(client *ethclient.Client, depositWindow *big.Int, authAcc
 
 // GetDepositAmount retrieves the deposit amount for a given address and window
 func GetDepositAmount(client *ethclient.Client, address common.Address, window big.Int) (*big.Int, error) {
-	bidderRegistryABI, err := LoadABI("abi/BidderRegistry.abi")
+	bidderRegistry, err := BidderRegistryContract(client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load ABI file: %v", err)
+		return nil, err
 	}
 
-	bidderRegistryContract := bind.NewBoundContract(common.HexToAddress(bidderRegistryAddress), bidderRegistryABI, client, client, client)
-
 	// Call the getDeposit function
-	var depositResult []interface{}
-	err = bidderRegistryContract.Call(nil, &depositResult, "minDeposit")
+	depositAmount, err := bidderRegistry.GetDeposit(nil, address, &window)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call getDeposit function: %v", err)
 	}
 
-	// Extract the deposit amount as *big.Int
-	depositAmount, ok := depositResult[0].(*big.Int)
-	if !ok {
-		return nil, fmt.Errorf("failed to convert deposit amount to *big.Int")
-	}
-
 	return depositAmount, nil
 }
 
-// WithdrawFromWindow withdraws all funds from the specified window
-func WithdrawFromWindow(client *ethclient.Client, authAcct *AuthAcct, window *big.Int) (*types.Transaction, error) {
-	// Load bidderRegistry contract
-	bidderRegistryABI, err := LoadABI("abi/BidderRegistry.abi")
+// WithdrawFromWindow withdraws all funds from the specified window, giving up when ctx is done
+func WithdrawFromWindow(ctx context.Context, client *ethclient.Client, authAcct *AuthAcct, window *big.Int) (*types.Transaction, error) {
+	bidderRegistry, err := BidderRegistryContract(client)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load ABI file: %v", err)
+		return nil, err
 	}
 
-	bidderRegistryContract := bind.NewBoundContract(common.HexToAddress(bidderRegistryAddress), bidderRegistryABI, client, client, client)
-
 	// Prepare the withdrawal transaction
-	withdrawalTx, err := bidderRegistryContract.Transact(authAcct.Auth, "withdrawBidderAmountFromWindow", authAcct.Address, window)
+	opts := *authAcct.Auth
+	opts.Context = ctx
+	withdrawalTx, err := bidderRegistry.WithdrawBidderAmountFromWindow(&opts, authAcct.Address, window)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create withdrawal transaction: %v", err)
 	}
 
 	// Wait for the withdrawal transaction to be mined
-	withdrawalReceipt, err := bind.WaitMined(context.Background(), client, withdrawalTx)
+	withdrawalReceipt, err := bind.WaitMined(ctx, client, withdrawalTx)
 	if err != nil {
 		return nil, fmt.Errorf("withdrawal transaction mining error: %v", err)
 	}
@@ -196,70 +152,3 @@ func WithdrawFromWindow(client *ethclient.Client, authAcct *AuthAcct, window *bi
 		return nil, fmt.Errorf("withdrawal failed")
 	}
 }
-
-// Event listener function. 
-// TODO - currently not listening correctly.
-func ListenForCommitmentStoredEvent(client *ethclient.Client) {
-	contractAbi, err := LoadABI("abi/PreConfCommitmentStore.abi") // Update with the correct path to your ABI file
-	if err != nil {
-		log.Fatalf("Failed to load contract ABI: %v", err)
-	}
-
-	headers := make(chan *types.Header)
-	sub, err := client.SubscribeNewHead(context.Background(), headers)
-	if err != nil {
-		log.Fatalf("Failed to subscribe to new head: %v", err)
-	}
-
-	for {
-		select {
-		case err := <-sub.Err():
-			log.Fatalf("Error with header subscription: %v", err)
-		case header := <-headers:
-			query := ethereum.FilterQuery{
-				Addresses: []common.Address{common.HexToAddress(preConfCommitmentStoreAddress)},
-				FromBlock: header.Number,
-				ToBlock:   header.Number,
-			}
-
-			logs := make(chan types.Log)
-			subLogs, err := client.SubscribeFilterLogs(context.Background(), query, logs)
-			if err != nil {
-				log.Printf("Failed to subscribe to logs: %v", err)
-				continue
-			}
-
-			for {
-				select {
-				case err := <-subLogs.Err():
-					log.Printf("Error with log subscription: %v", err)
-					break
-				case vLog := <-logs:
-					var event CommitmentStoredEvent
-
-					err := contractAbi.UnpackIntoInterface(&event, "CommitmentStored", vLog.Data)
-					if err != nil {
-						log.Printf("Failed to unpack log data: %v", err)
-						continue
-					}
-
-					fmt.Printf("CommitmentStored Event: \n")
-					fmt.Printf("CommitmentIndex: %x\n", event.CommitmentIndex)
-					fmt.Printf("Bidder: %s\n", event.Bidder.Hex())
-					fmt.Printf("Commiter: %s\n", event.Commiter.Hex())
-					fmt.Printf("Bid: %d\n", event.Bid)
-					fmt.Printf("BlockNumber: %d\n", event.BlockNumber)
-					fmt.Printf("BidHash: %x\n", event.BidHash)
-					fmt.Printf("DecayStartTimeStamp: %d\n", event.DecayStartTimeStamp)
-					fmt.Printf("DecayEndTimeStamp: %d\n", event.DecayEndTimeStamp)
-					fmt.Printf("TxnHash: %s\n", event.TxnHash)
-					fmt.Printf("CommitmentHash: %x\n", event.CommitmentHash)
-					fmt.Printf("BidSignature: %x\n", event.BidSignature)
-					fmt.Printf("CommitmentSignature: %x\n", event.CommitmentSignature)
-					fmt.Printf("DispatchTimestamp: %d\n", event.DispatchTimestamp)
-					fmt.Printf("SharedSecretKey: %x\n", event.SharedSecretKey)
-				}
-			}
-		}
-	}
-}
@@ -0,0 +1,290 @@
+package mevcommit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	abis "github.com/primev/preconf_blob_bidder/abi"
+	"github.com/primev/preconf_blob_bidder/core/contracts/preconfcommitmentstore"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+	logPollInterval   = 12 * time.Second
+	// maxLogRange is the largest block range requested in a single eth_getLogs call.
+	maxLogRange = 5000
+	// seenLogsLimit bounds the number of delivered logs remembered for deduplication.
+	seenLogsLimit = 10000
+	// reorgDepth is how many blocks behind the next block a delivered log is still remembered once seenLogsLimit is reached.
+	reorgDepth = 128
+)
+
+// CommitmentFilter selects the CommitmentStored events delivered by SubscribeCommitmentStored.
+// Empty lists match every address.
+type CommitmentFilter struct {
+	Bidders    []common.Address
+	Committers []common.Address
+	// FromBlock is the first mev-commit chain block to deliver events from. 0 starts at the current head.
+	FromBlock uint64
+}
+
+func (f CommitmentFilter) matches(bidder, committer common.Address) bool {
+	return matchesAddress(f.Bidders, bidder) && matchesAddress(f.Committers, committer)
+}
+
+func matchesAddress(list []common.Address, addr common.Address) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, a := range list {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// SubscribeCommitmentStored follows PreConfCommitmentStore CommitmentStored events on the mev-commit chain at
+// endpoint and delivers the decoded events matching filter on the returned channel, which is closed when ctx
+// is cancelled.
+//
+// Dropped connections are redialed with backoff and the blocks missed in between are backfilled, so no event is
+// lost or delivered twice. Logs removed by a reorg are delivered again with Raw.Removed set, so consumers can
+// undo them. bidder and commiter are not indexed in the event, so they are filtered after decoding. Endpoints
+// without subscription support (plain HTTP) are polled instead, in which case removed logs are not reported.
+func SubscribeCommitmentStored(ctx context.Context, endpoint string, filter CommitmentFilter) (<-chan CommitmentStoredEvent, error) {
+	contractAddr, err := contractAddress("PreConfCommitmentStore", currentNetwork.Contracts.PreConfCommitmentStore)
+	if err != nil {
+		return nil, err
+	}
+	contractAbi, err := abis.Parsed("PreConfCommitmentStore")
+	if err != nil {
+		return nil, err
+	}
+	// only the ABI is used to decode logs, so no backend is needed
+	filterer, err := preconfcommitmentstore.NewPreConfCommitmentStoreFilterer(contractAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan CommitmentStoredEvent)
+	follower := newLogFollower(endpoint, ethereum.FilterQuery{
+		Addresses: []common.Address{contractAddr},
+		Topics:    [][]common.Hash{{contractAbi.Events["CommitmentStored"].ID}},
+	}, filter.FromBlock, func(ctx context.Context, l types.Log) bool {
+		ev, err := filterer.ParseCommitmentStored(l)
+		if err != nil {
+			log.Warn("Failed to decode CommitmentStored log", "tx", l.TxHash, "index", l.Index, "error", err)
+			return true
+		}
+		if !filter.matches(ev.Bidder, ev.Commiter) {
+			return true
+		}
+		select {
+		case events <- CommitmentStoredEvent(*ev):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+
+	go func() {
+		defer close(events)
+		follower.run(ctx)
+	}()
+	return events, nil
+}
+
+// logKey identifies a log within a specific block, so the same log in a reorged block counts as a new log.
+type logKey struct {
+	blockHash common.Hash
+	index     uint
+}
+
+// logFollower delivers the logs matching a query, reconnecting and backfilling missed blocks as needed.
+type logFollower struct {
+	endpoint string
+	query    ethereum.FilterQuery
+	// next is the first block not yet fully delivered. 0 means start at the head.
+	next    uint64
+	seen    map[logKey]uint64
+	deliver func(ctx context.Context, l types.Log) bool
+}
+
+// newLogFollower creates a follower for query's addresses and topics. deliver returns false to stop early
+// when ctx is done.
+func newLogFollower(endpoint string, query ethereum.FilterQuery, fromBlock uint64, deliver func(context.Context, types.Log) bool) *logFollower {
+	query.FromBlock, query.ToBlock = nil, nil
+	return &logFollower{
+		endpoint: endpoint,
+		query:    query,
+		next:     fromBlock,
+		seen:     make(map[logKey]uint64),
+		deliver:  deliver,
+	}
+}
+
+// run follows logs until ctx is cancelled.
+func (f *logFollower) run(ctx context.Context) {
+	delay := minReconnectDelay
+	for ctx.Err() == nil {
+		err := f.connect(ctx, func() { delay = minReconnectDelay })
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warn("Log subscription failed, reconnecting", "endpoint", f.endpoint, "error", err, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// connect dials the endpoint and follows logs until the connection fails. connected is called once the
+// follower has caught up.
+func (f *logFollower) connect(ctx context.Context, connected func()) error {
+	rpcClient, err := rpc.DialContext(ctx, f.endpoint)
+	if err != nil {
+		return err
+	}
+	client := ethclient.NewClient(rpcClient)
+	defer client.Close()
+
+	logs := make(chan types.Log, 128)
+	sub, err := client.SubscribeFilterLogs(ctx, f.query, logs)
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return f.poll(ctx, client, connected)
+	}
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	// the subscription only delivers new logs, fetch the ones emitted while we were not subscribed
+	if err := f.backfill(ctx, client); err != nil {
+		return err
+	}
+	connected()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		case l := <-logs:
+			if !f.handle(ctx, l) {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// poll backfills new blocks every logPollInterval.
+func (f *logFollower) poll(ctx context.Context, client *ethclient.Client, connected func()) error {
+	if err := f.backfill(ctx, client); err != nil {
+		return err
+	}
+	connected()
+
+	ticker := time.NewTicker(logPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := f.backfill(ctx, client); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// backfill delivers the logs from next up to the current head.
+func (f *logFollower) backfill(ctx context.Context, client *ethclient.Client) error {
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if f.next == 0 {
+		f.next = head + 1
+		return nil
+	}
+
+	for f.next <= head {
+		to := f.next + maxLogRange - 1
+		if to > head {
+			to = head
+		}
+
+		query := f.query
+		query.FromBlock = new(big.Int).SetUint64(f.next)
+		query.ToBlock = new(big.Int).SetUint64(to)
+		logs, err := client.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
+		for _, l := range logs {
+			if !f.handle(ctx, l) {
+				return ctx.Err()
+			}
+		}
+		f.next = to + 1
+	}
+	return nil
+}
+
+// handle drops logs that were already delivered and removals of logs that never were, then delivers l.
+func (f *logFollower) handle(ctx context.Context, l types.Log) bool {
+	key := logKey{blockHash: l.BlockHash, index: l.Index}
+	_, seen := f.seen[key]
+	if l.Removed {
+		if !seen {
+			return true
+		}
+		delete(f.seen, key)
+	} else {
+		if seen {
+			return true
+		}
+		f.seen[key] = l.BlockNumber
+		// a reconnect may cut a block short, so its logs are fetched again and deduplicated
+		if l.BlockNumber > f.next {
+			f.next = l.BlockNumber
+		}
+		f.prune()
+	}
+	return f.deliver(ctx, l)
+}
+
+// prune forgets delivered logs that are too old to be removed by a reorg.
+func (f *logFollower) prune() {
+	if len(f.seen) < seenLogsLimit {
+		return
+	}
+	for key, block := range f.seen {
+		if block+reorgDepth < f.next {
+			delete(f.seen, key)
+		}
+	}
+}
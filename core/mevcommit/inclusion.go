@@ -0,0 +1,160 @@
+package mevcommit
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Inclusion outcomes of a commitment.
+const (
+	// InclusionHonored means the provider built the committed block and the tx landed in it.
+	InclusionHonored = "honored"
+	// InclusionViolated means the provider built the committed block but the tx landed elsewhere.
+	InclusionViolated = "violated"
+	// InclusionIrrelevant means another provider built the committed block, so the commitment could not be honored.
+	InclusionIrrelevant = "irrelevant"
+	// InclusionUnresolved means BlockTracker still had no winner for the committed block WinnerMaxAgeBlocks after it,
+	// so the oracle is not going to record one.
+	InclusionUnresolved = "unresolved"
+)
+
+// WinnerMaxAgeBlocks is how many L1 blocks past the committed block BlockTracker may have reached before a missing
+// winner marks the commitment InclusionUnresolved. It leaves the oracle several times its usual lag.
+const WinnerMaxAgeBlocks = 5 * DefaultOracleLagBlocks
+
+// InclusionResult is the outcome of one commitment once its tx landed.
+type InclusionResult struct {
+	TxHashes       []string
+	Provider       string
+	CommittedBlock uint64
+	IncludedBlock  uint64
+	Winner         common.Address
+	Outcome        string
+}
+
+// RecordInclusion records that txHash landed in the L1 block includedBlock, for every bid that included it.
+func (t *Tracker) RecordInclusion(txHash string, includedBlock uint64) error {
+	txHash = strings.ToLower(strings.TrimPrefix(txHash, "0x"))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	changed := false
+	for _, bid := range t.state.Bids {
+		for _, h := range bid.TxHashes {
+			if strings.ToLower(strings.TrimPrefix(h, "0x")) == txHash && bid.IncludedBlock != includedBlock {
+				bid.IncludedBlock = includedBlock
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return t.save()
+}
+
+// VerifyInclusions classifies the commitments of every landed bid against the winner BlockTracker recorded for
+// the committed block. The oracle records winners some blocks after L1, so commitments whose block has no winner
+// yet are left for a later call, until BlockTracker is WinnerMaxAgeBlocks past the block and they are marked
+// InclusionUnresolved. It returns the commitments classified by this call.
+func (t *Tracker) VerifyInclusions(client bind.ContractBackend) ([]InclusionResult, error) {
+	// read the winners without holding the lock, the calls can be slow
+	t.mu.Lock()
+	var blocks []uint64
+	for _, bid := range t.state.Bids {
+		if bid.IncludedBlock == 0 {
+			continue
+		}
+		for _, c := range bid.Commitments {
+			if c.Inclusion == "" {
+				blocks = append(blocks, uint64(bid.BlockNumber))
+				break
+			}
+		}
+	}
+	t.mu.Unlock()
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	blockTracker, err := BlockTrackerContract(client)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := FetchWindowSchedule(client, DefaultOracleLagBlocks)
+	if err != nil {
+		return nil, err
+	}
+	// the first block of the current window is the latest L1 block BlockTracker is known to have reached
+	var reachedBlock uint64
+	if schedule.CurrentWindow > 0 {
+		reachedBlock = (schedule.CurrentWindow-1)*schedule.BlocksPerWindow + 1
+	}
+
+	winners := make(map[uint64]common.Address)
+	for _, block := range blocks {
+		if _, ok := winners[block]; ok {
+			continue
+		}
+		winner, err := blockTracker.GetBlockWinner(nil, new(big.Int).SetUint64(block))
+		if err != nil {
+			return nil, fmt.Errorf("failed to call getBlockWinner function for block %d: %v", block, err)
+		}
+		winners[block] = winner
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var results []InclusionResult
+	for _, bid := range t.state.Bids {
+		committedBlock := uint64(bid.BlockNumber)
+		winner, ok := winners[committedBlock]
+		if bid.IncludedBlock == 0 || !ok {
+			continue
+		}
+		unresolved := winner == (common.Address{})
+		if unresolved && committedBlock+WinnerMaxAgeBlocks > reachedBlock {
+			continue
+		}
+		for _, c := range bid.Commitments {
+			if c.Inclusion != "" {
+				continue
+			}
+			if unresolved {
+				c.Inclusion = InclusionUnresolved
+			} else {
+				c.Inclusion = classifyInclusion(common.HexToAddress(c.Provider), winner, committedBlock, bid.IncludedBlock)
+				c.BlockWinner = winner.Hex()
+			}
+			results = append(results, InclusionResult{
+				TxHashes:       bid.TxHashes,
+				Provider:       c.Provider,
+				CommittedBlock: committedBlock,
+				IncludedBlock:  bid.IncludedBlock,
+				Winner:         winner,
+				Outcome:        c.Inclusion,
+			})
+		}
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+	return results, t.save()
+}
+
+func classifyInclusion(provider, winner common.Address, committedBlock, includedBlock uint64) string {
+	switch {
+	case provider != winner:
+		return InclusionIrrelevant
+	case committedBlock == includedBlock:
+		return InclusionHonored
+	default:
+		return InclusionViolated
+	}
+}
@@ -0,0 +1,130 @@
+package mevcommit
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultOracleLagBlocks is how many L1 blocks the oracle lags behind before it settles a block.
+const DefaultOracleLagBlocks = 20
+
+// WindowStatus says where a bidding window is relative to the current window.
+type WindowStatus string
+
+const (
+	// WindowFuture is a window that has not started yet.
+	WindowFuture WindowStatus = "future"
+	// WindowCurrent is the window bids are currently made against.
+	WindowCurrent WindowStatus = "current"
+	// WindowPast is a window that has ended but may not be settled by the oracle yet.
+	WindowPast WindowStatus = "past"
+	// WindowWithdrawable is a window that has ended and whose blocks have all been settled by the oracle.
+	WindowWithdrawable WindowStatus = "withdrawable"
+)
+
+// WindowSchedule holds the BlockTracker state needed to classify windows.
+type WindowSchedule struct {
+	CurrentWindow   uint64
+	BlocksPerWindow uint64
+	OracleLagBlocks uint64
+}
+
+// FetchWindowSchedule reads the current window and blocks per window from BlockTracker.
+func FetchWindowSchedule(client bind.ContractBackend, oracleLagBlocks uint64) (*WindowSchedule, error) {
+	blockTracker, err := BlockTrackerContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	currentWindow, err := blockTracker.GetCurrentWindow(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getCurrentWindow function: %v", err)
+	}
+	blocksPerWindow, err := blockTracker.GetBlocksPerWindow(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getBlocksPerWindow function: %v", err)
+	}
+	if blocksPerWindow.Sign() == 0 {
+		return nil, fmt.Errorf("blocksPerWindow is zero")
+	}
+
+	return &WindowSchedule{
+		CurrentWindow:   currentWindow.Uint64(),
+		BlocksPerWindow: blocksPerWindow.Uint64(),
+		OracleLagBlocks: oracleLagBlocks,
+	}, nil
+}
+
+// LastWithdrawableWindow returns the most recent window whose blocks have all been settled, or 0 if there is none.
+// Window w covers L1 blocks (w-1)*blocksPerWindow+1 to w*blocksPerWindow, and the current window has at least
+// reached its first block, so w is settled once w*blocksPerWindow+oracleLag <= (current-1)*blocksPerWindow+1.
+func (s WindowSchedule) LastWithdrawableWindow() uint64 {
+	if s.CurrentWindow == 0 {
+		return 0
+	}
+	currentBlock := (s.CurrentWindow-1)*s.BlocksPerWindow + 1
+	if currentBlock < s.OracleLagBlocks {
+		return 0
+	}
+	return (currentBlock - s.OracleLagBlocks) / s.BlocksPerWindow
+}
+
+// Status classifies window relative to the current window.
+func (s WindowSchedule) Status(window uint64) WindowStatus {
+	switch {
+	case window > s.CurrentWindow:
+		return WindowFuture
+	case window == s.CurrentWindow:
+		return WindowCurrent
+	case window <= s.LastWithdrawableWindow():
+		return WindowWithdrawable
+	default:
+		return WindowPast
+	}
+}
+
+// LedgerRow is a bidder's balance in a single window.
+type LedgerRow struct {
+	Window      uint64
+	Deposit     *big.Int
+	LockedFunds *big.Int
+	Status      WindowStatus
+}
+
+// DepositLedger returns the deposit and locked funds of bidder in every window from fromWindow to toWindow inclusive.
+func DepositLedger(client bind.ContractBackend, bidder common.Address, fromWindow, toWindow uint64, schedule *WindowSchedule) ([]LedgerRow, error) {
+	if fromWindow > toWindow {
+		return nil, fmt.Errorf("invalid window range %d-%d", fromWindow, toWindow)
+	}
+
+	bidderRegistry, err := BidderRegistryContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]LedgerRow, 0, toWindow-fromWindow+1)
+	for w := fromWindow; w <= toWindow; w++ {
+		window := new(big.Int).SetUint64(w)
+
+		deposit, err := bidderRegistry.GetDeposit(nil, bidder, window)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call getDeposit function for window %d: %v", w, err)
+		}
+		locked, err := bidderRegistry.LockedFunds(nil, bidder, window)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call lockedFunds function for window %d: %v", w, err)
+		}
+
+		rows = append(rows, LedgerRow{
+			Window:      w,
+			Deposit:     deposit,
+			LockedFunds: locked,
+			Status:      schedule.Status(w),
+		})
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,177 @@
+package mevcommit
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ContractAddresses holds the addresses of the mev-commit contracts in abi/. L1Gateway and ValidatorRegistry
+// live on L1, the others on the mev-commit chain. A zero address means the contract is not configured.
+type ContractAddresses struct {
+	BidderRegistry         common.Address `json:"bidder_registry" yaml:"bidder_registry"`
+	BlockTracker           common.Address `json:"block_tracker" yaml:"block_tracker"`
+	PreConfCommitmentStore common.Address `json:"preconf_commitment_store" yaml:"preconf_commitment_store"`
+	Oracle                 common.Address `json:"oracle" yaml:"oracle"`
+	ProviderRegistry       common.Address `json:"provider_registry" yaml:"provider_registry"`
+	SettlementGateway      common.Address `json:"settlement_gateway" yaml:"settlement_gateway"`
+	L1Gateway              common.Address `json:"l1_gateway" yaml:"l1_gateway"`
+	ValidatorRegistry      common.Address `json:"validator_registry" yaml:"validator_registry"`
+}
+
+// Network describes a mev-commit deployment: the L1 it settles on, the mev-commit chain and the contract addresses.
+type Network struct {
+	Name             string            `json:"name" yaml:"name"`
+	L1ChainID        uint64            `json:"l1_chain_id" yaml:"l1_chain_id"`
+	MevCommitChainID uint64            `json:"mev_commit_chain_id" yaml:"mev_commit_chain_id"`
+	L1RPC            string            `json:"l1_rpc" yaml:"l1_rpc"`
+	MevCommitRPC     string            `json:"mev_commit_rpc" yaml:"mev_commit_rpc"`
+	Contracts        ContractAddresses `json:"contracts" yaml:"contracts"`
+}
+
+// DefaultNetwork is the network used when none is configured.
+const DefaultNetwork = "holesky"
+
+// networks holds the presets and any custom networks registered with RegisterNetwork.
+// Addresses that are not known for a preset are left empty and must be set through a custom entry.
+var networks = map[string]Network{
+	"holesky": {
+		Name:             "holesky",
+		L1ChainID:        17000,
+		MevCommitChainID: 17864,
+		L1RPC:            "https://ethereum-holesky-rpc.publicnode.com",
+		MevCommitRPC:     "https://chainrpc.testnet.mev-commit.xyz",
+		Contracts: ContractAddresses{
+			BidderRegistry:         common.HexToAddress("0x7ffa86fF89489Bca72Fec2a978e33f9870B2Bd25"),
+			BlockTracker:           common.HexToAddress("0x2eEbF31f5c932D51556E70235FB98bB2237d065c"),
+			PreConfCommitmentStore: common.HexToAddress("0xCAC68D97a56b19204Dd3dbDC103CB24D47A825A3"),
+			Oracle:                 common.HexToAddress("0x6856Eb630C79D491886E104D328834643e8a5a47"),
+			ProviderRegistry:       common.HexToAddress("0x4FC9b98e1A0Ff10de4c2cf294656854F1d5B207D"),
+			SettlementGateway:      common.HexToAddress("0xc1f93bE11D7472c9B9a4d87B41dD0a491F1fbc75"),
+			L1Gateway:              common.HexToAddress("0x1a18dfEc4f2B66207b1Ad30aB5c7A0d62Ef4A40b"),
+			ValidatorRegistry:      common.HexToAddress("0x5d4fC7B5Aeea4CF4F0Ca6Be09A2F5AaDAd2F2803"),
+		},
+	},
+	"hoodi": {
+		Name:      "hoodi",
+		L1ChainID: 560048,
+		L1RPC:     "https://ethereum-hoodi-rpc.publicnode.com",
+	},
+	"mainnet": {
+		Name:             "mainnet",
+		L1ChainID:        1,
+		MevCommitChainID: 8855,
+		L1RPC:            "https://ethereum-rpc.publicnode.com",
+		MevCommitRPC:     "https://chainrpc.mev-commit.xyz",
+	},
+	"devnet": {
+		Name:             "devnet",
+		L1ChainID:        31337,
+		MevCommitChainID: 17864,
+		L1RPC:            "http://localhost:8545",
+		MevCommitRPC:     "http://localhost:8555",
+	},
+}
+
+// currentNetwork is the network the contract helpers talk to. It is set once at startup with SetNetwork.
+var currentNetwork = networks[DefaultNetwork]
+
+// RegisterNetwork adds a custom network. An entry named after a registered network only overrides the values
+// it sets, so a config can fill in or change single addresses of a preset.
+func RegisterNetwork(n Network) error {
+	if n.Name == "" {
+		return fmt.Errorf("network name is required")
+	}
+	if base, ok := networks[n.Name]; ok {
+		n = base.merge(n)
+	}
+	if n.L1ChainID == 0 && n.MevCommitChainID == 0 {
+		return fmt.Errorf("network %q needs at least one chain ID", n.Name)
+	}
+	networks[n.Name] = n
+	return nil
+}
+
+// merge returns n with the non-empty values of other applied on top.
+func (n Network) merge(other Network) Network {
+	if other.L1ChainID != 0 {
+		n.L1ChainID = other.L1ChainID
+	}
+	if other.MevCommitChainID != 0 {
+		n.MevCommitChainID = other.MevCommitChainID
+	}
+	setIfNotEmpty(&n.L1RPC, other.L1RPC)
+	setIfNotEmpty(&n.MevCommitRPC, other.MevCommitRPC)
+
+	c, o := &n.Contracts, other.Contracts
+	setAddressIfNotZero(&c.BidderRegistry, o.BidderRegistry)
+	setAddressIfNotZero(&c.BlockTracker, o.BlockTracker)
+	setAddressIfNotZero(&c.PreConfCommitmentStore, o.PreConfCommitmentStore)
+	setAddressIfNotZero(&c.Oracle, o.Oracle)
+	setAddressIfNotZero(&c.ProviderRegistry, o.ProviderRegistry)
+	setAddressIfNotZero(&c.SettlementGateway, o.SettlementGateway)
+	setAddressIfNotZero(&c.L1Gateway, o.L1Gateway)
+	setAddressIfNotZero(&c.ValidatorRegistry, o.ValidatorRegistry)
+	return n
+}
+
+func setAddressIfNotZero(dst *common.Address, value common.Address) {
+	if value != (common.Address{}) {
+		*dst = value
+	}
+}
+
+// LookupNetwork returns the registered network with the given name.
+func LookupNetwork(name string) (Network, error) {
+	n, ok := networks[name]
+	if !ok {
+		return Network{}, fmt.Errorf("unknown network %q, known networks: %v", name, NetworkNames())
+	}
+	return n, nil
+}
+
+// NetworkNames returns the names of all registered networks, sorted.
+func NetworkNames() []string {
+	names := make([]string, 0, len(networks))
+	for name := range networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetNetwork selects the network used by the contract helpers in this package.
+func SetNetwork(n Network) {
+	currentNetwork = n
+}
+
+// CurrentNetwork returns the network used by the contract helpers in this package.
+func CurrentNetwork() Network {
+	return currentNetwork
+}
+
+// L1ChainIDBig returns the L1 chain ID as a *big.Int, or nil if it is not set.
+func (n Network) L1ChainIDBig() *big.Int {
+	if n.L1ChainID == 0 {
+		return nil
+	}
+	return new(big.Int).SetUint64(n.L1ChainID)
+}
+
+// MevCommitChainIDBig returns the mev-commit chain ID as a *big.Int, or nil if it is not set.
+func (n Network) MevCommitChainIDBig() *big.Int {
+	if n.MevCommitChainID == 0 {
+		return nil
+	}
+	return new(big.Int).SetUint64(n.MevCommitChainID)
+}
+
+// contractAddress returns addr, or an error naming the contract if it is not configured for the current network.
+func contractAddress(name string, addr common.Address) (common.Address, error) {
+	if addr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%s address is not configured for network %q", name, currentNetwork.Name)
+	}
+	return addr, nil
+}
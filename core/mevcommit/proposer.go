@@ -0,0 +1,136 @@
+package mevcommit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/preconf_blob_bidder/core/beacon"
+)
+
+// ErrNoOptedInProposer is returned when no proposer in the lookahead is opted in to mev-commit.
+var ErrNoOptedInProposer = errors.New("no opted-in proposer in the lookahead")
+
+// stakedCacheTTL is how long an isStaked result is reused, one epoch.
+const stakedCacheTTL = beacon.SlotsPerEpoch * beacon.SecondsPerSlot * time.Second
+
+type stakedResult struct {
+	staked    bool
+	checkedAt time.Time
+}
+
+// ProposerLookahead finds the upcoming L1 blocks whose proposer is opted in to mev-commit, i.e. has its BLS key
+// staked in ValidatorRegistry. Duties come from the beacon node API for the current and next epoch.
+//
+// Blocks are mapped to slots by assuming every slot from the head on produces a block, so a missed slot shifts
+// the mapping by one until the next call.
+type ProposerLookahead struct {
+	beacon *beacon.Client
+	client *ethclient.Client
+
+	duties map[uint64][]beacon.ProposerDuty
+	staked map[string]stakedResult
+}
+
+// NewProposerLookahead creates a lookahead using the beacon node API at beaconURL and the L1 client, which is
+// also used to call ValidatorRegistry.
+func NewProposerLookahead(beaconURL string, client *ethclient.Client) *ProposerLookahead {
+	return &ProposerLookahead{
+		beacon: beacon.NewClient(beaconURL),
+		client: client,
+		duties: make(map[uint64][]beacon.ProposerDuty),
+		staked: make(map[string]stakedResult),
+	}
+}
+
+// NextOptedInBlock returns the first L1 block at or after minBlock whose proposer is opted in, and its duty.
+// It returns ErrNoOptedInProposer if there is none until the end of the next epoch.
+func (l *ProposerLookahead) NextOptedInBlock(ctx context.Context, minBlock uint64) (uint64, beacon.ProposerDuty, error) {
+	headSlot, err := l.beacon.HeadSlot(ctx)
+	if err != nil {
+		return 0, beacon.ProposerDuty{}, err
+	}
+	headBlock, err := l.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, beacon.ProposerDuty{}, fmt.Errorf("failed to get block number: %v", err)
+	}
+
+	// the head slot holds the head block, each later slot the next block
+	firstSlot := headSlot + 1
+	if minBlock > headBlock {
+		firstSlot = headSlot + (minBlock - headBlock)
+	}
+
+	epoch := headSlot / beacon.SlotsPerEpoch
+	for e := range l.duties {
+		if e < epoch {
+			delete(l.duties, e)
+		}
+	}
+	for e := epoch; e <= epoch+1; e++ {
+		duties, err := l.epochDuties(ctx, e, e == epoch)
+		if err != nil {
+			return 0, beacon.ProposerDuty{}, err
+		}
+		for _, duty := range duties {
+			if duty.Slot < firstSlot {
+				continue
+			}
+			staked, err := l.isStaked(duty.Pubkey)
+			if err != nil {
+				return 0, beacon.ProposerDuty{}, err
+			}
+			if staked {
+				return headBlock + (duty.Slot - headSlot), duty, nil
+			}
+		}
+	}
+
+	return 0, beacon.ProposerDuty{}, ErrNoOptedInProposer
+}
+
+// epochDuties returns the proposer duties of epoch, sorted by slot. Only duties of the head epoch are cached:
+// they are fixed once it started, while the next epoch's can still change until then.
+func (l *ProposerLookahead) epochDuties(ctx context.Context, epoch uint64, cache bool) ([]beacon.ProposerDuty, error) {
+	if duties, ok := l.duties[epoch]; ok {
+		return duties, nil
+	}
+
+	duties, err := l.beacon.ProposerDuties(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(duties, func(i, j int) bool { return duties[i].Slot < duties[j].Slot })
+
+	if cache {
+		l.duties[epoch] = duties
+	}
+	return duties, nil
+}
+
+// isStaked checks the proposer's BLS key with ValidatorRegistry.isStaked, reusing results for an epoch.
+func (l *ProposerLookahead) isStaked(pubkey string) (bool, error) {
+	if r, ok := l.staked[pubkey]; ok && time.Since(r.checkedAt) < stakedCacheTTL {
+		return r.staked, nil
+	}
+
+	key, err := hexutil.Decode(pubkey)
+	if err != nil {
+		return false, fmt.Errorf("invalid BLS pubkey %q: %v", pubkey, err)
+	}
+	validatorRegistry, err := ValidatorRegistryContract(l.client)
+	if err != nil {
+		return false, err
+	}
+	staked, err := validatorRegistry.IsStaked(nil, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to call isStaked function: %v", err)
+	}
+
+	l.staked[pubkey] = stakedResult{staked: staked, checkedAt: time.Now()}
+	return staked, nil
+}
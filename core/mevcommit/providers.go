@@ -0,0 +1,125 @@
+package mevcommit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/preconf_blob_bidder/core/store"
+)
+
+// ProviderScore summarizes how a provider has handled our bids.
+type ProviderScore struct {
+	Provider    string `json:"provider"`
+	Commitments int    `json:"commitments"`
+	// MedianLatencyMs is the median time between sending a bid and the provider dispatching its commitment.
+	MedianLatencyMs int64 `json:"medianLatencyMs"`
+	// Processed is the number of commitments the oracle processed, Slashed how many of them were slashed.
+	Processed int     `json:"processed"`
+	Slashed   int     `json:"slashed"`
+	SlashRate float64 `json:"slashRate"`
+	// Stake is the provider's stake in ProviderRegistry in wei, empty if it could not be read.
+	Stake string `json:"stake,omitempty"`
+}
+
+// ProviderScoreboard is the scores of every provider that committed to our bids.
+type ProviderScoreboard struct {
+	UpdatedAt int64            `json:"updatedAt"`
+	Providers []*ProviderScore `json:"providers"`
+}
+
+// ProviderScoreboard scores every provider that committed to a tracked bid, most commitments first.
+// Stakes are not set, see FetchProviderStakes.
+func (t *Tracker) ProviderScoreboard() *ProviderScoreboard {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	scores := make(map[string]*ProviderScore)
+	latencies := make(map[string][]int64)
+	for _, bid := range t.state.Bids {
+		for _, c := range bid.Commitments {
+			provider := strings.ToLower(c.Provider)
+			score, ok := scores[provider]
+			if !ok {
+				score = &ProviderScore{Provider: common.HexToAddress(c.Provider).Hex()}
+				scores[provider] = score
+			}
+
+			score.Commitments++
+			if c.DispatchTimestamp > 0 && bid.SentAt > 0 {
+				latencies[provider] = append(latencies[provider], c.DispatchTimestamp-bid.SentAt)
+			}
+			if stateOrder[c.State] >= stateOrder[StateProcessed] {
+				score.Processed++
+				if c.Slashed {
+					score.Slashed++
+				}
+			}
+		}
+	}
+
+	board := &ProviderScoreboard{UpdatedAt: time.Now().Unix()}
+	for provider, score := range scores {
+		score.MedianLatencyMs = median(latencies[provider])
+		if score.Processed > 0 {
+			score.SlashRate = float64(score.Slashed) / float64(score.Processed)
+		}
+		board.Providers = append(board.Providers, score)
+	}
+	sort.Slice(board.Providers, func(i, j int) bool {
+		if board.Providers[i].Commitments != board.Providers[j].Commitments {
+			return board.Providers[i].Commitments > board.Providers[j].Commitments
+		}
+		return board.Providers[i].Provider < board.Providers[j].Provider
+	})
+	return board
+}
+
+// FetchProviderStakes sets the stake of every provider on the board from ProviderRegistry.checkStake.
+func FetchProviderStakes(client bind.ContractBackend, board *ProviderScoreboard) error {
+	providerRegistry, err := ProviderRegistryContract(client)
+	if err != nil {
+		return err
+	}
+
+	for _, score := range board.Providers {
+		stake, err := providerRegistry.CheckStake(nil, common.HexToAddress(score.Provider))
+		if err != nil {
+			return fmt.Errorf("failed to call checkStake function for %s: %v", score.Provider, err)
+		}
+		score.Stake = stake.String()
+	}
+	return nil
+}
+
+// SaveProviderScoreboard writes board to path.
+func SaveProviderScoreboard(path string, board *ProviderScoreboard) error {
+	return store.WriteJSON(path, board)
+}
+
+// LoadProviderScoreboard reads the board saved at path. A missing file gives an empty board.
+func LoadProviderScoreboard(path string) (*ProviderScoreboard, error) {
+	var board ProviderScoreboard
+	if err := store.ReadJSON(path, &board); err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// median returns the median of values, or 0 if there are none.
+func median(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]int64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
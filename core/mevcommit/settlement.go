@@ -0,0 +1,186 @@
+package mevcommit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ProviderSettlement totals the settled commitments of a bidder with one provider in one window.
+type ProviderSettlement struct {
+	// Provider is the zero address when the provider of a refunded commitment is unknown.
+	Provider    common.Address
+	Commitments int
+	// Paid is the amount rewarded to the provider.
+	Paid *big.Int
+	// Refunded is the amount returned to the bidder after the provider was slashed.
+	Refunded *big.Int
+}
+
+// WindowSettlement totals the settlements, withdrawals and locked funds of a bidder in one window.
+type WindowSettlement struct {
+	Window    uint64
+	Paid      *big.Int
+	Refunded  *big.Int
+	Withdrawn *big.Int
+	// Locked is the bidder's lockedFunds for the window at the time of the report.
+	Locked    *big.Int
+	Providers []*ProviderSettlement
+}
+
+// SettlementReport is what a bidder paid, got refunded, withdrew and still has locked, by window and provider.
+type SettlementReport struct {
+	Bidder    common.Address
+	FromBlock uint64
+	ToBlock   uint64
+	Windows   []*WindowSettlement
+	Paid      *big.Int
+	Refunded  *big.Int
+	Withdrawn *big.Int
+	Locked    *big.Int
+}
+
+// BuildSettlementReport reads the FundsRewarded, FundsRetrieved and BidderWithdrawal events of bidder between
+// fromBlock and toBlock on the mev-commit chain, with toBlock 0 meaning the current head. Windows the bidder
+// deposited for in the range are included while they still have funds locked. FundsRetrieved does not
+// name the provider, so providers maps commitment digests to providers, e.g. from Tracker.CommitmentProviders.
+func BuildSettlementReport(client *ethclient.Client, bidder common.Address, fromBlock, toBlock uint64, providers map[common.Hash]common.Address) (*SettlementReport, error) {
+	bidderRegistry, err := BidderRegistryContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if toBlock == 0 {
+		toBlock, err = client.BlockNumber(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block number: %v", err)
+		}
+	}
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range %d-%d", fromBlock, toBlock)
+	}
+
+	report := &SettlementReport{
+		Bidder:    bidder,
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Paid:      new(big.Int),
+		Refunded:  new(big.Int),
+		Withdrawn: new(big.Int),
+		Locked:    new(big.Int),
+	}
+	windows := make(map[uint64]*WindowSettlement)
+	window := func(w *big.Int) *WindowSettlement {
+		ws, ok := windows[w.Uint64()]
+		if !ok {
+			ws = &WindowSettlement{Window: w.Uint64(), Paid: new(big.Int), Refunded: new(big.Int), Withdrawn: new(big.Int), Locked: new(big.Int)}
+			windows[w.Uint64()] = ws
+		}
+		return ws
+	}
+	provider := func(ws *WindowSettlement, addr common.Address) *ProviderSettlement {
+		for _, ps := range ws.Providers {
+			if ps.Provider == addr {
+				return ps
+			}
+		}
+		ps := &ProviderSettlement{Provider: addr, Paid: new(big.Int), Refunded: new(big.Int)}
+		ws.Providers = append(ws.Providers, ps)
+		return ps
+	}
+
+	for start := fromBlock; start <= toBlock; start += maxLogRange {
+		end := start + maxLogRange - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		opts := &bind.FilterOpts{Start: start, End: &end}
+
+		rewarded, err := bidderRegistry.FilterFundsRewarded(opts, nil, []common.Address{bidder}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter FundsRewarded events: %v", err)
+		}
+		for rewarded.Next() {
+			ev := rewarded.Event
+			ps := provider(window(ev.Window), ev.Provider)
+			ps.Commitments++
+			ps.Paid.Add(ps.Paid, ev.Amount)
+		}
+		if err := rewarded.Error(); err != nil {
+			return nil, fmt.Errorf("failed to read FundsRewarded events: %v", err)
+		}
+
+		retrieved, err := bidderRegistry.FilterFundsRetrieved(opts, nil, []common.Address{bidder})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter FundsRetrieved events: %v", err)
+		}
+		for retrieved.Next() {
+			ev := retrieved.Event
+			ps := provider(window(ev.Window), providers[ev.CommitmentDigest])
+			ps.Commitments++
+			ps.Refunded.Add(ps.Refunded, ev.Amount)
+		}
+		if err := retrieved.Error(); err != nil {
+			return nil, fmt.Errorf("failed to read FundsRetrieved events: %v", err)
+		}
+
+		// windows the bidder deposited for may still have funds locked without any settlement yet
+		deposits, err := bidderRegistry.FilterBidderRegistered(opts, []common.Address{bidder})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter BidderRegistered events: %v", err)
+		}
+		for deposits.Next() {
+			window(deposits.Event.WindowNumber)
+		}
+		if err := deposits.Error(); err != nil {
+			return nil, fmt.Errorf("failed to read BidderRegistered events: %v", err)
+		}
+
+		withdrawals, err := bidderRegistry.FilterBidderWithdrawal(opts, []common.Address{bidder})
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter BidderWithdrawal events: %v", err)
+		}
+		for withdrawals.Next() {
+			ws := window(withdrawals.Event.Window)
+			ws.Withdrawn.Add(ws.Withdrawn, withdrawals.Event.Amount)
+		}
+		if err := withdrawals.Error(); err != nil {
+			return nil, fmt.Errorf("failed to read BidderWithdrawal events: %v", err)
+		}
+	}
+
+	for w, ws := range windows {
+		locked, err := bidderRegistry.LockedFunds(nil, bidder, new(big.Int).SetUint64(w))
+		if err != nil {
+			return nil, fmt.Errorf("failed to call lockedFunds function for window %d: %v", w, err)
+		}
+		ws.Locked = locked
+		if locked.Sign() == 0 && len(ws.Providers) == 0 && ws.Withdrawn.Sign() == 0 {
+			continue
+		}
+
+		for _, ps := range ws.Providers {
+			ws.Paid.Add(ws.Paid, ps.Paid)
+			ws.Refunded.Add(ws.Refunded, ps.Refunded)
+		}
+		sort.Slice(ws.Providers, func(i, j int) bool {
+			return ws.Providers[i].Provider.Hex() < ws.Providers[j].Provider.Hex()
+		})
+
+		report.Paid.Add(report.Paid, ws.Paid)
+		report.Refunded.Add(report.Refunded, ws.Refunded)
+		report.Withdrawn.Add(report.Withdrawn, ws.Withdrawn)
+		report.Locked.Add(report.Locked, ws.Locked)
+		report.Windows = append(report.Windows, ws)
+	}
+	sort.Slice(report.Windows, func(i, j int) bool {
+		return report.Windows[i].Window < report.Windows[j].Window
+	})
+
+	return report, nil
+}
@@ -0,0 +1,226 @@
+package mevcommit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Signer signs transactions for a single account. Every transaction an AuthAcct sends is signed through it, so
+// with a remote signer the key never has to be in this process.
+type Signer interface {
+	// Address is the account the signer signs for.
+	Address() common.Address
+	// SignTx returns tx signed for chainID. Blob sidecars are kept.
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// keySigner signs with a private key held in memory.
+type keySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner returns a signer for a hex encoded private key. Meant for development, prefer a keystore or a remote
+// signer elsewhere.
+func NewKeySigner(privateKeyHex string) (Signer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load private key: %v", err)
+	}
+	return &keySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}, nil
+}
+
+// NewKeystoreSigner returns a signer for the encrypted geth keystore file at path, decrypted with password.
+func NewKeystoreSigner(path, password string) (Signer, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %v", err)
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore file %s: %v", path, err)
+	}
+	return &keySigner{key: key.PrivateKey, address: key.Address}, nil
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *keySigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// RemoteSigner methods.
+const (
+	// RemoteSignerWeb3Signer is web3signer's eth_signTransaction, which returns the raw signed transaction.
+	RemoteSignerWeb3Signer = "eth_signTransaction"
+	// RemoteSignerClef is clef's account_signTransaction, which returns the raw and decoded signed transaction.
+	RemoteSignerClef = "account_signTransaction"
+)
+
+// remoteSigner signs through the JSON-RPC API of a remote signer.
+type remoteSigner struct {
+	client  *rpc.Client
+	method  string
+	address common.Address
+}
+
+// NewRemoteSigner returns a signer that asks the remote signer at url to sign for address with method,
+// RemoteSignerWeb3Signer or RemoteSignerClef.
+func NewRemoteSigner(url, method string, address common.Address) (Signer, error) {
+	switch method {
+	case RemoteSignerWeb3Signer, RemoteSignerClef:
+	default:
+		return nil, fmt.Errorf("invalid remote signer method %q, use %s or %s", method, RemoteSignerWeb3Signer, RemoteSignerClef)
+	}
+
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %v", err)
+	}
+	return &remoteSigner{client: client, method: method, address: address}, nil
+}
+
+func (s *remoteSigner) Address() common.Address {
+	return s.address
+}
+
+// remoteTxArgs is the transaction object web3signer and clef take, like eth_sendTransaction. Blobs are not sent:
+// the signature only covers their versioned hashes.
+type remoteTxArgs struct {
+	From                 common.MixedcaseAddress  `json:"from"`
+	To                   *common.MixedcaseAddress `json:"to,omitempty"`
+	Gas                  hexutil.Uint64           `json:"gas"`
+	GasPrice             *hexutil.Big             `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big             `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big             `json:"maxPriorityFeePerGas,omitempty"`
+	Value                hexutil.Big              `json:"value"`
+	Nonce                hexutil.Uint64           `json:"nonce"`
+	Data                 hexutil.Bytes            `json:"data"`
+	Input                hexutil.Bytes            `json:"input"`
+	AccessList           *types.AccessList        `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big             `json:"chainId"`
+	BlobFeeCap           *hexutil.Big             `json:"maxFeePerBlobGas,omitempty"`
+	BlobHashes           []common.Hash            `json:"blobVersionedHashes,omitempty"`
+}
+
+func (s *remoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := remoteTxArgs{
+		From:    common.NewMixedcaseAddress(s.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		Input:   tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if to := tx.To(); to != nil {
+		mixed := common.NewMixedcaseAddress(*to)
+		args.To = &mixed
+	}
+	if tx.Type() == types.LegacyTxType {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	} else {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+	if tx.Type() == types.BlobTxType {
+		args.BlobFeeCap = (*hexutil.Big)(tx.BlobGasFeeCap())
+		args.BlobHashes = tx.BlobHashes()
+	}
+
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, s.method, args); err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign transaction: %v", err)
+	}
+	raw, err := decodeSignResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := new(types.Transaction)
+	if err := remote.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("invalid transaction from remote signer: %v", err)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(remote) != signer.Hash(tx) {
+		return nil, fmt.Errorf("remote signer signed a different transaction")
+	}
+	if from, err := types.Sender(signer, remote); err != nil || from != s.address {
+		return nil, fmt.Errorf("remote signer did not sign the transaction as %s", s.address.Hex())
+	}
+	if tx.BlobTxSidecar() == nil {
+		return remote, nil
+	}
+
+	// put the signature on our transaction, which still has the blob sidecar
+	v, r, sv := remote.RawSignatureValues()
+	signature := make([]byte, crypto.SignatureLength)
+	r.FillBytes(signature[:32])
+	sv.FillBytes(signature[32:64])
+	signature[64] = byte(v.Uint64())
+	return tx.WithSignature(signer, signature)
+}
+
+// decodeSignResult returns the raw signed transaction of a web3signer or clef response.
+func decodeSignResult(result json.RawMessage) ([]byte, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err == nil {
+		return raw, nil
+	}
+	var clef struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &clef); err != nil || len(clef.Raw) == 0 {
+		return nil, fmt.Errorf("unexpected remote signer response: %s", result)
+	}
+	return clef.Raw, nil
+}
+
+// NewSigner returns the signer cfg selects: a keystore file, a remote signer, or else the hex encoded private key.
+// promptPassword is called for the keystore password when cfg has no password file. It returns nil if none of them
+// is set.
+func NewSigner(cfg SignerConfig, privateKeyHex string, promptPassword func(prompt string) (string, error)) (Signer, error) {
+	switch {
+	case cfg.Keystore != "":
+		var password string
+		if cfg.PasswordFile != "" {
+			data, err := os.ReadFile(cfg.PasswordFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read keystore password file: %v", err)
+			}
+			password = strings.TrimRight(string(data), "\r\n")
+		} else {
+			var err error
+			if password, err = promptPassword(fmt.Sprintf("Password for %s: ", cfg.Keystore)); err != nil {
+				return nil, fmt.Errorf("failed to read keystore password: %v", err)
+			}
+		}
+		return NewKeystoreSigner(cfg.Keystore, password)
+	case cfg.RemoteURL != "":
+		method := cfg.RemoteMethod
+		if method == "" {
+			method = RemoteSignerWeb3Signer
+		}
+		return NewRemoteSigner(cfg.RemoteURL, method, common.HexToAddress(cfg.Address))
+	case privateKeyHex != "":
+		return NewKeySigner(privateKeyHex)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,160 @@
+package mevcommit
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// BidContext holds the inputs a BidStrategy can use to price a preconf bid.
+type BidContext struct {
+	// Attempt is the number of preconf bids already sent for the tx, starting at 1 for the first bid.
+	Attempt int
+	// NumBlobs is the number of blobs carried by the tx. Zero for non-blob txs.
+	NumBlobs int
+	// BlobBaseFee is the blob base fee in wei expected for the target block. May be nil.
+	BlobBaseFee *big.Int
+}
+
+// BidStrategy decides how much to bid, in wei, for a preconfirmation.
+type BidStrategy interface {
+	BidAmount(bc BidContext) *big.Int
+}
+
+// FixedStrategy always bids the same amount, capped at Max if set.
+type FixedStrategy struct {
+	Amount *big.Int
+	Max    *big.Int
+}
+
+func (s FixedStrategy) BidAmount(bc BidContext) *big.Int {
+	return capAmount(new(big.Int).Set(s.Amount), s.Max)
+}
+
+// LinearStrategy bids Base on the first attempt and adds Step for every resend, capped at Max if set.
+type LinearStrategy struct {
+	Base *big.Int
+	Step *big.Int
+	Max  *big.Int
+}
+
+func (s LinearStrategy) BidAmount(bc BidContext) *big.Int {
+	resends := int64(bc.Attempt - 1)
+	if resends < 0 {
+		resends = 0
+	}
+	amount := new(big.Int).Mul(s.Step, big.NewInt(resends))
+	amount.Add(amount, s.Base)
+	return capAmount(amount, s.Max)
+}
+
+// ExponentialStrategy bids Base on the first attempt and multiplies by Factor for every resend, capped at Max if set.
+type ExponentialStrategy struct {
+	Base   *big.Int
+	Factor float64
+	Max    *big.Int
+}
+
+func (s ExponentialStrategy) BidAmount(bc BidContext) *big.Int {
+	amount := new(big.Float).SetInt(s.Base)
+	factor := big.NewFloat(s.Factor)
+	for i := 1; i < bc.Attempt; i++ {
+		amount.Mul(amount, factor)
+		// stop multiplying once the cap is reached, the result won't change
+		if s.Max != nil && amount.Cmp(new(big.Float).SetInt(s.Max)) >= 0 {
+			break
+		}
+	}
+	result, _ := amount.Int(nil)
+	return capAmount(result, s.Max)
+}
+
+// FeeIndexedStrategy bids a multiple of the blob fee the tx is expected to pay,
+// i.e. BlobBaseFee * GasPerBlob * NumBlobs * Multiplier. Min is used as a floor and
+// as the bid when the blob base fee is unknown.
+type FeeIndexedStrategy struct {
+	Multiplier float64
+	Min        *big.Int
+	Max        *big.Int
+}
+
+func (s FeeIndexedStrategy) BidAmount(bc BidContext) *big.Int {
+	if bc.BlobBaseFee == nil || bc.NumBlobs == 0 {
+		return new(big.Int).Set(s.Min)
+	}
+
+	blobFee := new(big.Int).Mul(bc.BlobBaseFee, big.NewInt(int64(params.BlobTxBlobGasPerBlob*bc.NumBlobs)))
+	scaled := new(big.Float).Mul(new(big.Float).SetInt(blobFee), big.NewFloat(s.Multiplier))
+	amount, _ := scaled.Int(nil)
+
+	if amount.Cmp(s.Min) < 0 {
+		amount.Set(s.Min)
+	}
+	return capAmount(amount, s.Max)
+}
+
+func capAmount(amount, max *big.Int) *big.Int {
+	if max != nil && max.Sign() > 0 && amount.Cmp(max) > 0 {
+		return new(big.Int).Set(max)
+	}
+	return amount
+}
+
+// StrategyConfig holds the command line settings used to build a BidStrategy.
+type StrategyConfig struct {
+	Name       string  `json:"name" yaml:"name"`
+	Amount     string  `json:"amount" yaml:"amount"`
+	Step       string  `json:"step" yaml:"step"`
+	Factor     float64 `json:"factor" yaml:"factor"`
+	Multiplier float64 `json:"multiplier" yaml:"multiplier"`
+	Max        string  `json:"max" yaml:"max"`
+}
+
+// NewBidStrategy builds one of the built-in strategies ("fixed", "linear", "exponential" or "fee") from cfg.
+// Amount is the base bid in wei, and the minimum bid for the fee strategy.
+func NewBidStrategy(cfg StrategyConfig) (BidStrategy, error) {
+	amount, err := parseWei(cfg.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+
+	var max *big.Int
+	if cfg.Max != "" {
+		max, err = parseWei(cfg.Max, "max")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch cfg.Name {
+	case "", "fixed":
+		return FixedStrategy{Amount: amount, Max: max}, nil
+	case "linear":
+		step, err := parseWei(cfg.Step, "step")
+		if err != nil {
+			return nil, err
+		}
+		return LinearStrategy{Base: amount, Step: step, Max: max}, nil
+	case "exponential":
+		if cfg.Factor < 1 {
+			return nil, fmt.Errorf("exponential strategy factor must be >= 1, got %v", cfg.Factor)
+		}
+		return ExponentialStrategy{Base: amount, Factor: cfg.Factor, Max: max}, nil
+	case "fee":
+		if cfg.Multiplier <= 0 {
+			return nil, fmt.Errorf("fee strategy multiplier must be > 0, got %v", cfg.Multiplier)
+		}
+		return FeeIndexedStrategy{Multiplier: cfg.Multiplier, Min: amount, Max: max}, nil
+	default:
+		return nil, fmt.Errorf("unknown bid strategy %q", cfg.Name)
+	}
+}
+
+func parseWei(value, name string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s %q: must be a non-negative integer amount in wei", name, value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,64 @@
+package mevcommit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestBidStrategies(t *testing.T) {
+	// blob fee of one blob at a blob base fee of 1 wei
+	blobFee := int64(params.BlobTxBlobGasPerBlob)
+
+	tests := []struct {
+		name string
+		cfg  StrategyConfig
+		bc   BidContext
+		want int64
+	}{
+		{"fixed", StrategyConfig{Name: "fixed", Amount: "100"}, BidContext{Attempt: 3}, 100},
+		{"fixed default name", StrategyConfig{Amount: "100"}, BidContext{Attempt: 1}, 100},
+		{"fixed capped", StrategyConfig{Name: "fixed", Amount: "100", Max: "60"}, BidContext{Attempt: 1}, 60},
+
+		{"linear first attempt", StrategyConfig{Name: "linear", Amount: "100", Step: "10"}, BidContext{Attempt: 1}, 100},
+		{"linear third attempt", StrategyConfig{Name: "linear", Amount: "100", Step: "10"}, BidContext{Attempt: 3}, 120},
+		{"linear zero attempt", StrategyConfig{Name: "linear", Amount: "100", Step: "10"}, BidContext{Attempt: 0}, 100},
+		{"linear capped", StrategyConfig{Name: "linear", Amount: "100", Step: "10", Max: "115"}, BidContext{Attempt: 3}, 115},
+
+		{"exponential first attempt", StrategyConfig{Name: "exponential", Amount: "100", Factor: 2}, BidContext{Attempt: 1}, 100},
+		{"exponential third attempt", StrategyConfig{Name: "exponential", Amount: "100", Factor: 2}, BidContext{Attempt: 3}, 400},
+		{"exponential capped", StrategyConfig{Name: "exponential", Amount: "100", Factor: 2, Max: "300"}, BidContext{Attempt: 10}, 300},
+
+		{"fee", StrategyConfig{Name: "fee", Amount: "1", Multiplier: 2}, BidContext{NumBlobs: 3, BlobBaseFee: big.NewInt(1)}, 6 * blobFee},
+		{"fee floor", StrategyConfig{Name: "fee", Amount: "1000000000", Multiplier: 2}, BidContext{NumBlobs: 1, BlobBaseFee: big.NewInt(1)}, 1000000000},
+		{"fee nil blob fee", StrategyConfig{Name: "fee", Amount: "100", Multiplier: 2}, BidContext{NumBlobs: 3}, 100},
+		{"fee no blobs", StrategyConfig{Name: "fee", Amount: "100", Multiplier: 2}, BidContext{BlobBaseFee: big.NewInt(1)}, 100},
+		{"fee capped", StrategyConfig{Name: "fee", Amount: "1", Multiplier: 2, Max: "5000"}, BidContext{NumBlobs: 3, BlobBaseFee: big.NewInt(1)}, 5000},
+	}
+
+	for _, tt := range tests {
+		strategy, err := NewBidStrategy(tt.cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := strategy.BidAmount(tt.bc); got.Cmp(big.NewInt(tt.want)) != 0 {
+			t.Errorf("%s: got %s, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewBidStrategyErrors(t *testing.T) {
+	for _, cfg := range []StrategyConfig{
+		{Name: "fixed", Amount: "-1"},
+		{Name: "fixed", Amount: "100", Max: "abc"},
+		{Name: "linear", Amount: "100", Step: ""},
+		{Name: "exponential", Amount: "100", Factor: 0.5},
+		{Name: "fee", Amount: "100", Multiplier: 0},
+		{Name: "unknown", Amount: "100"},
+	} {
+		if _, err := NewBidStrategy(cfg); err == nil {
+			t.Errorf("%+v: expected an error", cfg)
+		}
+	}
+}
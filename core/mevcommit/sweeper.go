@@ -0,0 +1,179 @@
+package mevcommit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	abis "github.com/primev/preconf_blob_bidder/abi"
+	"github.com/primev/preconf_blob_bidder/core/store"
+)
+
+// SweeperConfig holds the settings of a Sweeper.
+type SweeperConfig struct {
+	// Endpoint is the mev-commit chain endpoint the BlockTracker events are followed on.
+	Endpoint string
+	// StatePath is the JSON file the sweeper keeps its progress and withdrawal records in.
+	StatePath string
+	// OracleLagBlocks is how many L1 blocks the oracle lags behind before a block is settled.
+	OracleLagBlocks uint64
+	// StartWindow is the first window to sweep when there is no saved state. 0 starts Lookback windows
+	// before the current window.
+	StartWindow uint64
+	// Lookback is how many windows before the current window to scan when there is no saved state and no StartWindow.
+	Lookback uint64
+}
+
+// WithdrawalRecord is a withdrawal made by the sweeper.
+type WithdrawalRecord struct {
+	Window    uint64 `json:"window"`
+	Amount    string `json:"amount"`
+	TxHash    string `json:"txHash"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// sweeperState is persisted after every window so a restarted sweeper picks up where it stopped.
+type sweeperState struct {
+	NextWindow  uint64             `json:"nextWindow"`
+	Withdrawals []WithdrawalRecord `json:"withdrawals"`
+}
+
+// Sweeper withdraws the bidder's deposit from each window as soon as the oracle has settled it. It follows
+// BlockTracker NewL1Block and NewWindow events to learn when windows settle, resubscribing with backoff when
+// the connection fails and polling for the events when the endpoint can't subscribe.
+type Sweeper struct {
+	client   *ethclient.Client
+	authAcct *AuthAcct
+	cfg      SweeperConfig
+	state    sweeperState
+
+	blocksPerWindow uint64
+}
+
+// NewSweeper creates a sweeper for authAcct, loading any saved state from cfg.StatePath.
+func NewSweeper(client *ethclient.Client, authAcct *AuthAcct, cfg SweeperConfig) (*Sweeper, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("sweeper endpoint is required")
+	}
+	if cfg.StatePath == "" {
+		cfg.StatePath = "data/sweeper.json"
+	}
+
+	s := &Sweeper{client: client, authAcct: authAcct, cfg: cfg}
+	if err := store.ReadJSON(cfg.StatePath, &s.state); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Withdrawals returns the withdrawals made so far, including those from previous runs.
+func (s *Sweeper) Withdrawals() []WithdrawalRecord {
+	return s.state.Withdrawals
+}
+
+// Run sweeps windows until ctx is cancelled. Windows that settled while the sweeper was down are swept first.
+func (s *Sweeper) Run(ctx context.Context) error {
+	schedule, err := FetchWindowSchedule(s.client, s.cfg.OracleLagBlocks)
+	if err != nil {
+		return err
+	}
+	s.blocksPerWindow = schedule.BlocksPerWindow
+
+	if s.state.NextWindow == 0 {
+		s.state.NextWindow = s.cfg.StartWindow
+		if s.state.NextWindow == 0 {
+			s.state.NextWindow = 1
+			if schedule.CurrentWindow > s.cfg.Lookback {
+				s.state.NextWindow = schedule.CurrentWindow - s.cfg.Lookback
+			}
+		}
+	}
+
+	// catch up on windows settled while we were down
+	if err := s.sweep(ctx, schedule.LastWithdrawableWindow()); err != nil {
+		log.Error("Failed to sweep windows", "error", err)
+	}
+
+	blockTracker, err := BlockTrackerContract(s.client)
+	if err != nil {
+		return err
+	}
+	blockTrackerAbi, err := abis.Parsed("BlockTracker")
+	if err != nil {
+		return err
+	}
+
+	follower := newLogFollower(s.cfg.Endpoint, ethereum.FilterQuery{
+		Addresses: []common.Address{currentNetwork.Contracts.BlockTracker},
+		Topics:    [][]common.Hash{{blockTrackerAbi.Events["NewL1Block"].ID, blockTrackerAbi.Events["NewWindow"].ID}},
+	}, 0, func(ctx context.Context, l types.Log) bool {
+		if l.Removed {
+			return true
+		}
+		if ev, err := blockTracker.ParseNewWindow(l); err == nil {
+			// blocksPerWindow can change between windows
+			schedule, err := FetchWindowSchedule(s.client, s.cfg.OracleLagBlocks)
+			if err != nil {
+				log.Error("Failed to refresh window schedule", "window", ev.Window, "error", err)
+				return true
+			}
+			s.blocksPerWindow = schedule.BlocksPerWindow
+		} else if ev, err := blockTracker.ParseNewL1Block(l); err == nil {
+			// each NewL1Block tells exactly which L1 block the chain has reached, so windows are swept as soon
+			// as their last block is past the oracle lag
+			if err := s.sweep(ctx, s.settledWindow(ev.BlockNumber.Uint64())); err != nil {
+				log.Error("Failed to sweep windows", "l1Block", ev.BlockNumber, "error", err)
+			}
+		}
+		return ctx.Err() == nil
+	})
+	follower.run(ctx)
+	return ctx.Err()
+}
+
+// settledWindow returns the last window fully settled once L1 has reached l1Block.
+func (s *Sweeper) settledWindow(l1Block uint64) uint64 {
+	if l1Block < s.cfg.OracleLagBlocks {
+		return 0
+	}
+	return (l1Block - s.cfg.OracleLagBlocks) / s.blocksPerWindow
+}
+
+// sweep withdraws from every window from NextWindow up to lastSettled that still holds a deposit.
+// Progress is saved after each window, and a failed withdrawal stops the sweep so it is retried next time.
+func (s *Sweeper) sweep(ctx context.Context, lastSettled uint64) error {
+	for s.state.NextWindow <= lastSettled {
+		window := new(big.Int).SetUint64(s.state.NextWindow)
+
+		deposit, err := GetDepositAmount(s.client, s.authAcct.Address, *window)
+		if err != nil {
+			return err
+		}
+
+		if deposit.Sign() > 0 {
+			tx, err := WithdrawFromWindow(ctx, s.client, s.authAcct, window)
+			if err != nil {
+				return fmt.Errorf("failed to withdraw from window %d: %w", s.state.NextWindow, err)
+			}
+			log.Info("Withdrew from window", "window", s.state.NextWindow, "amount", deposit, "tx", tx.Hash())
+			s.state.Withdrawals = append(s.state.Withdrawals, WithdrawalRecord{
+				Window:    s.state.NextWindow,
+				Amount:    deposit.String(),
+				TxHash:    tx.Hash().Hex(),
+				Timestamp: time.Now().Unix(),
+			})
+		}
+
+		s.state.NextWindow++
+		if err := store.WriteJSON(s.cfg.StatePath, s.state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
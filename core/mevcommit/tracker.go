@@ -0,0 +1,564 @@
+package mevcommit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	abis "github.com/primev/preconf_blob_bidder/abi"
+	pb "github.com/primev/preconf_blob_bidder/core/bidderpb"
+	"github.com/primev/preconf_blob_bidder/core/store"
+)
+
+// CommitmentState is a step in the lifecycle of a bid and its commitments.
+type CommitmentState string
+
+const (
+	// StateBidSent means the bid was sent to the bidder node and no commitment was received yet.
+	StateBidSent CommitmentState = "bid_sent"
+	// StateCommitmentReceived means a provider returned a commitment through the bidder node.
+	StateCommitmentReceived CommitmentState = "commitment_received"
+	// StateEncryptedStored means the provider stored the encrypted commitment (EncryptedCommitmentStored).
+	StateEncryptedStored CommitmentState = "encrypted_stored"
+	// StateOpened means the commitment was opened on chain (CommitmentStored).
+	StateOpened CommitmentState = "opened"
+	// StateProcessed means the oracle processed the commitment (CommitmentProcessed). Slashed tells the outcome.
+	StateProcessed CommitmentState = "processed"
+	// StateSettled means BidderRegistry paid the provider (FundsRewarded) or returned the bid (FundsRetrieved).
+	StateSettled CommitmentState = "settled"
+)
+
+var stateOrder = map[CommitmentState]int{
+	StateBidSent:            0,
+	StateCommitmentReceived: 1,
+	StateEncryptedStored:    2,
+	StateOpened:             3,
+	StateProcessed:          4,
+	StateSettled:            5,
+}
+
+// Settlement outcomes of a commitment.
+const (
+	SettlementRewarded  = "rewarded"
+	SettlementRetrieved = "retrieved"
+)
+
+// Transition records when a commitment reached a state, and the log that moved it there if it came from the chain.
+// Like every tracker timestamp, Timestamp is in unix milliseconds.
+type Transition struct {
+	State     CommitmentState `json:"state"`
+	Timestamp int64           `json:"timestampMs"`
+	Block     uint64          `json:"block,omitempty"`
+	BlockHash string          `json:"blockHash,omitempty"`
+	TxHash    string          `json:"txHash,omitempty"`
+	LogIndex  uint            `json:"logIndex,omitempty"`
+}
+
+// CommitmentRecord follows a single provider commitment to a bid.
+type CommitmentRecord struct {
+	Digest   string `json:"digest"`
+	Provider string `json:"provider"`
+	// DispatchTimestamp is when the provider dispatched the commitment in unix milliseconds.
+	DispatchTimestamp int64           `json:"dispatchTimestamp"`
+	Index             string          `json:"index,omitempty"`
+	State             CommitmentState `json:"state"`
+	Slashed           bool            `json:"slashed,omitempty"`
+	// Settlement is SettlementRewarded or SettlementRetrieved once the commitment is settled.
+	Settlement    string `json:"settlement,omitempty"`
+	SettledAmount string `json:"settledAmount,omitempty"`
+	Window        uint64 `json:"window,omitempty"`
+	// Inclusion is InclusionHonored, InclusionViolated or InclusionIrrelevant once the tx landed and the winner
+	// of the committed block is known, or InclusionUnresolved if the winner never was. BlockWinner is that winner.
+	Inclusion   string       `json:"inclusion,omitempty"`
+	BlockWinner string       `json:"blockWinner,omitempty"`
+	History     []Transition `json:"history"`
+}
+
+// BidRecord is a bid sent to the bidder node and the commitments received for it.
+type BidRecord struct {
+	BidDigest   string   `json:"bidDigest"`
+	TxHashes    []string `json:"txHashes"`
+	Amount      string   `json:"amount"`
+	BlockNumber int64    `json:"blockNumber"`
+	DecayStart  int64    `json:"decayStartTimestamp"`
+	DecayEnd    int64    `json:"decayEndTimestamp"`
+	// SentAt is when the bid was sent in unix milliseconds, like the commitments' dispatch timestamps.
+	SentAt int64 `json:"sentAtMs"`
+	// MevCommitBlock is the mev-commit chain head when the bid was sent, 0 if unknown. None of the bid's
+	// commitment events can be earlier.
+	MevCommitBlock uint64 `json:"mevCommitBlock,omitempty"`
+	// IncludedBlock is the L1 block the bid's tx landed in, 0 while it is pending.
+	IncludedBlock uint64 `json:"includedBlock,omitempty"`
+	// ReplacedBy is the tx that replaced the bid's tx with higher fees. Later bids are sent for that tx.
+	ReplacedBy  string              `json:"replacedBy,omitempty"`
+	Commitments []*CommitmentRecord `json:"commitments"`
+}
+
+// State returns the most advanced state among the bid's commitments, or StateBidSent if there are none.
+func (b *BidRecord) State() CommitmentState {
+	state := StateBidSent
+	for _, c := range b.Commitments {
+		if stateOrder[c.State] > stateOrder[state] {
+			state = c.State
+		}
+	}
+	return state
+}
+
+// trackerState is persisted after every change.
+type trackerState struct {
+	// NextBlock is the mev-commit chain block Follow resumes from.
+	NextBlock uint64       `json:"nextBlock"`
+	Bids      []*BidRecord `json:"bids"`
+}
+
+// Tracker follows every bid sent through the bidder node until it is settled in BidderRegistry. Bids and
+// commitments are recorded as they are sent and received, and Follow moves the commitments along as the
+// PreConfCommitmentStore, Oracle and BidderRegistry events come in. The state is kept in a JSON file.
+type Tracker struct {
+	mu    sync.Mutex
+	path  string
+	state trackerState
+
+	bids     map[string]*BidRecord
+	byDigest map[common.Hash]*CommitmentRecord
+	byIndex  map[common.Hash]*CommitmentRecord
+}
+
+// NewTracker creates a tracker that keeps its state in path, loading any saved state.
+func NewTracker(path string) (*Tracker, error) {
+	if path == "" {
+		path = "data/tracker.json"
+	}
+
+	t := &Tracker{
+		path:     path,
+		bids:     make(map[string]*BidRecord),
+		byDigest: make(map[common.Hash]*CommitmentRecord),
+		byIndex:  make(map[common.Hash]*CommitmentRecord),
+	}
+	if err := store.ReadJSON(path, &t.state); err != nil {
+		return nil, err
+	}
+	for _, bid := range t.state.Bids {
+		t.bids[bid.BidDigest] = bid
+		for _, c := range bid.Commitments {
+			t.index(c)
+		}
+	}
+	return t, nil
+}
+
+// RecordBid records a bid that was sent to the bidder node at sentAt, when the mev-commit chain was at
+// mevCommitBlock.
+func (t *Tracker) RecordBid(bid *pb.Bid, sentAt time.Time, mevCommitBlock uint64) error {
+	digest, err := bidDigest(bid)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.bids[digest]; ok {
+		return nil
+	}
+	record := &BidRecord{
+		BidDigest:      digest,
+		TxHashes:       bid.TxHashes,
+		Amount:         bid.Amount,
+		BlockNumber:    bid.BlockNumber,
+		DecayStart:     bid.DecayStartTimestamp,
+		DecayEnd:       bid.DecayEndTimestamp,
+		SentAt:         sentAt.UnixMilli(),
+		MevCommitBlock: mevCommitBlock,
+	}
+	t.bids[digest] = record
+	t.state.Bids = append(t.state.Bids, record)
+	return t.save()
+}
+
+// ForgetBid removes a bid recorded with RecordBid that turned out not to be sent.
+func (t *Tracker) ForgetBid(bid *pb.Bid) error {
+	digest, err := bidDigest(bid)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	record, ok := t.bids[digest]
+	if !ok {
+		return nil
+	}
+	delete(t.bids, digest)
+	for i, b := range t.state.Bids {
+		if b == record {
+			t.state.Bids = append(t.state.Bids[:i], t.state.Bids[i+1:]...)
+			break
+		}
+	}
+	for _, c := range record.Commitments {
+		delete(t.byDigest, common.HexToHash(c.Digest))
+		if c.Index != "" {
+			delete(t.byIndex, common.HexToHash(c.Index))
+		}
+	}
+	return t.save()
+}
+
+// RecordCommitment records a commitment received for bid, which must have been recorded with RecordBid.
+func (t *Tracker) RecordCommitment(bid *pb.Bid, c *pb.Commitment) error {
+	digest, err := bidDigest(bid)
+	if err != nil {
+		return err
+	}
+	commitmentDigest, err := decodeHash(c.CommitmentDigest)
+	if err != nil {
+		return fmt.Errorf("invalid commitment digest: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	record, ok := t.bids[digest]
+	if !ok {
+		return fmt.Errorf("bid %s is not tracked", digest)
+	}
+	if _, ok := t.byDigest[commitmentDigest]; ok {
+		return nil
+	}
+
+	commitment := &CommitmentRecord{
+		Digest:            commitmentDigest.Hex(),
+		Provider:          c.ProviderAddress,
+		DispatchTimestamp: c.DispatchTimestamp,
+		State:             StateCommitmentReceived,
+		History:           []Transition{{State: StateCommitmentReceived, Timestamp: time.Now().UnixMilli()}},
+	}
+	record.Commitments = append(record.Commitments, commitment)
+	t.index(commitment)
+	return t.save()
+}
+
+// RecordReplacement records that oldHash was replaced by newHash, a tx with the same nonce and higher fees.
+func (t *Tracker) RecordReplacement(oldHash, newHash string) error {
+	oldHash = strings.ToLower(strings.TrimPrefix(oldHash, "0x"))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	changed := false
+	for _, bid := range t.state.Bids {
+		for _, h := range bid.TxHashes {
+			if strings.ToLower(strings.TrimPrefix(h, "0x")) == oldHash && bid.ReplacedBy != newHash {
+				bid.ReplacedBy = newHash
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return t.save()
+}
+
+// BidsForTx returns copies of the bids that included txHash or a tx it replaced or was replaced by, oldest first.
+func (t *Tracker) BidsForTx(txHash string) []BidRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// collect the replacement chain txHash is part of
+	chain := map[string]bool{strings.ToLower(strings.TrimPrefix(txHash, "0x")): true}
+	for changed := true; changed; {
+		changed = false
+		for _, bid := range t.state.Bids {
+			if bid.ReplacedBy == "" || !bidForAny(bid, chain) && !chain[strings.ToLower(strings.TrimPrefix(bid.ReplacedBy, "0x"))] {
+				continue
+			}
+			for _, h := range append([]string{bid.ReplacedBy}, bid.TxHashes...) {
+				if h = strings.ToLower(strings.TrimPrefix(h, "0x")); !chain[h] {
+					chain[h] = true
+					changed = true
+				}
+			}
+		}
+	}
+
+	var bids []BidRecord
+	for _, bid := range t.state.Bids {
+		if bidForAny(bid, chain) {
+			bids = append(bids, copyBidRecord(bid))
+		}
+	}
+	return bids
+}
+
+// bidForAny reports whether bid included any of txHashes, lower case without 0x prefix.
+func bidForAny(bid *BidRecord, txHashes map[string]bool) bool {
+	for _, h := range bid.TxHashes {
+		if txHashes[strings.ToLower(strings.TrimPrefix(h, "0x"))] {
+			return true
+		}
+	}
+	return false
+}
+
+// CommitmentProviders maps the digest of every tracked commitment to the provider that made it.
+func (t *Tracker) CommitmentProviders() map[common.Hash]common.Address {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	providers := make(map[common.Hash]common.Address, len(t.byDigest))
+	for digest, c := range t.byDigest {
+		providers[digest] = common.HexToAddress(c.Provider)
+	}
+	return providers
+}
+
+// Follow moves the tracked commitments along as their events are emitted on the mev-commit chain at endpoint,
+// until ctx is cancelled. It resumes from the last block with a tracked event, or on the first run from the
+// earliest bid with unsettled commitments, so events emitted while the tracker was not running are applied.
+// Contracts without an address on the current network are skipped.
+func (t *Tracker) Follow(ctx context.Context, endpoint string) error {
+	var (
+		addresses []common.Address
+		topics    []common.Hash
+	)
+	for _, source := range []struct {
+		contract string
+		address  common.Address
+		events   []string
+	}{
+		{"PreConfCommitmentStore", currentNetwork.Contracts.PreConfCommitmentStore, []string{"EncryptedCommitmentStored", "CommitmentStored"}},
+		{"Oracle", currentNetwork.Contracts.Oracle, []string{"CommitmentProcessed"}},
+		{"BidderRegistry", currentNetwork.Contracts.BidderRegistry, []string{"FundsRewarded", "FundsRetrieved"}},
+	} {
+		if _, err := contractAddress(source.contract, source.address); err != nil {
+			log.Warn("Not tracking contract events", "error", err)
+			continue
+		}
+		contractAbi, err := abis.Parsed(source.contract)
+		if err != nil {
+			return err
+		}
+		addresses = append(addresses, source.address)
+		for _, name := range source.events {
+			topics = append(topics, contractAbi.Events[name].ID)
+		}
+	}
+	if len(addresses) == 0 {
+		return fmt.Errorf("no contract to track is configured for network %q", currentNetwork.Name)
+	}
+
+	t.mu.Lock()
+	fromBlock := t.state.NextBlock
+	if fromBlock == 0 {
+		fromBlock = t.earliestUnsettled()
+	}
+	t.mu.Unlock()
+
+	follower := newLogFollower(endpoint, ethereum.FilterQuery{
+		Addresses: addresses,
+		Topics:    [][]common.Hash{topics},
+	}, fromBlock, func(ctx context.Context, l types.Log) bool {
+		if err := t.apply(l); err != nil {
+			log.Warn("Failed to apply commitment event", "tx", l.TxHash, "index", l.Index, "error", err)
+		}
+		return true
+	})
+	follower.run(ctx)
+	return ctx.Err()
+}
+
+// earliestUnsettled returns the lowest mev-commit block among the bids with a commitment that is not settled,
+// or 0 if there is none.
+func (t *Tracker) earliestUnsettled() uint64 {
+	var earliest uint64
+	for _, bid := range t.state.Bids {
+		if bid.MevCommitBlock == 0 || earliest != 0 && bid.MevCommitBlock >= earliest {
+			continue
+		}
+		for _, c := range bid.Commitments {
+			if c.State != StateSettled {
+				earliest = bid.MevCommitBlock
+				break
+			}
+		}
+	}
+	return earliest
+}
+
+// apply moves the commitment l refers to, if it is tracked.
+func (t *Tracker) apply(l types.Log) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		record *CommitmentRecord
+		state  CommitmentState
+		update func()
+	)
+	switch l.Address {
+	case currentNetwork.Contracts.PreConfCommitmentStore:
+		store, err := PreConfCommitmentStoreContract(nil)
+		if err != nil {
+			return err
+		}
+		if ev, err := store.ParseEncryptedCommitmentStored(l); err == nil {
+			record, state = t.byDigest[ev.CommitmentDigest], StateEncryptedStored
+			update = func() { t.setIndex(record, ev.CommitmentIndex) }
+		} else if ev, err := store.ParseCommitmentStored(l); err == nil {
+			record, state = t.lookup(ev.CommitmentHash, ev.CommitmentIndex), StateOpened
+			update = func() { t.setIndex(record, ev.CommitmentIndex) }
+		} else {
+			return err
+		}
+	case currentNetwork.Contracts.Oracle:
+		oracle, err := OracleContract(nil)
+		if err != nil {
+			return err
+		}
+		ev, err := oracle.ParseCommitmentProcessed(l)
+		if err != nil {
+			return err
+		}
+		record, state = t.lookup(ev.CommitmentHash, ev.CommitmentHash), StateProcessed
+		update = func() { record.Slashed = ev.IsSlash }
+	case currentNetwork.Contracts.BidderRegistry:
+		registry, err := BidderRegistryContract(nil)
+		if err != nil {
+			return err
+		}
+		if ev, err := registry.ParseFundsRewarded(l); err == nil {
+			record, state = t.lookup(ev.CommitmentDigest, ev.CommitmentDigest), StateSettled
+			update = func() { record.settle(SettlementRewarded, ev.Amount, ev.Window) }
+		} else if ev, err := registry.ParseFundsRetrieved(l); err == nil {
+			record, state = t.lookup(ev.CommitmentDigest, ev.CommitmentDigest), StateSettled
+			update = func() { record.settle(SettlementRetrieved, ev.Amount, ev.Window) }
+		} else {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	// saved with the next tracked event
+	t.state.NextBlock = l.BlockNumber
+	if record == nil {
+		return nil
+	}
+
+	if l.Removed {
+		record.revert(l)
+	} else if record.advance(state, l) {
+		update()
+	}
+	return t.save()
+}
+
+// lookup finds a commitment by digest or by commitment index.
+func (t *Tracker) lookup(digest, index common.Hash) *CommitmentRecord {
+	if c, ok := t.byDigest[digest]; ok {
+		return c
+	}
+	return t.byIndex[index]
+}
+
+func (t *Tracker) setIndex(c *CommitmentRecord, index common.Hash) {
+	c.Index = index.Hex()
+	t.byIndex[index] = c
+}
+
+func (t *Tracker) index(c *CommitmentRecord) {
+	t.byDigest[common.HexToHash(c.Digest)] = c
+	if c.Index != "" {
+		t.byIndex[common.HexToHash(c.Index)] = c
+	}
+}
+
+func (t *Tracker) save() error {
+	return store.WriteJSON(t.path, t.state)
+}
+
+// advance records the transition to state caused by l. It returns false if l was already applied.
+func (c *CommitmentRecord) advance(state CommitmentState, l types.Log) bool {
+	for _, tr := range c.History {
+		if tr.BlockHash == l.BlockHash.Hex() && tr.LogIndex == l.Index && tr.State == state {
+			return false
+		}
+	}
+	c.History = append(c.History, Transition{
+		State:     state,
+		Timestamp: time.Now().UnixMilli(),
+		Block:     l.BlockNumber,
+		BlockHash: l.BlockHash.Hex(),
+		TxHash:    l.TxHash.Hex(),
+		LogIndex:  l.Index,
+	})
+	if stateOrder[state] > stateOrder[c.State] {
+		c.State = state
+	}
+	return true
+}
+
+// revert drops the transition caused by l after a reorg removed it. The fields set by the transition are kept,
+// since the log is normally included again in the new chain.
+func (c *CommitmentRecord) revert(l types.Log) {
+	history := c.History[:0]
+	for _, tr := range c.History {
+		if tr.BlockHash == l.BlockHash.Hex() && tr.LogIndex == l.Index {
+			continue
+		}
+		history = append(history, tr)
+	}
+	c.History = history
+
+	c.State = StateCommitmentReceived
+	for _, tr := range c.History {
+		if stateOrder[tr.State] > stateOrder[c.State] {
+			c.State = tr.State
+		}
+	}
+}
+
+func (c *CommitmentRecord) settle(settlement string, amount, window *big.Int) {
+	c.Settlement = settlement
+	c.SettledAmount = amount.String()
+	c.Window = window.Uint64()
+}
+
+func copyBidRecord(b *BidRecord) BidRecord {
+	bid := *b
+	bid.TxHashes = append([]string(nil), b.TxHashes...)
+	bid.Commitments = make([]*CommitmentRecord, len(b.Commitments))
+	for i, c := range b.Commitments {
+		commitment := *c
+		commitment.History = append([]Transition(nil), c.History...)
+		bid.Commitments[i] = &commitment
+	}
+	return bid
+}
+
+// bidDigest returns the digest PreConfCommitmentStore computes for bid, which identifies the bid in the tracker.
+func bidDigest(bid *pb.Bid) (string, error) {
+	amount, ok := new(big.Int).SetString(bid.Amount, 10)
+	if !ok || !amount.IsUint64() {
+		return "", fmt.Errorf("invalid bid amount %q", bid.Amount)
+	}
+	return DefaultPreConfDomain.BidHash(
+		strings.Join(bid.TxHashes, ","),
+		amount.Uint64(),
+		uint64(bid.BlockNumber),
+		uint64(bid.DecayStartTimestamp),
+		uint64(bid.DecayEndTimestamp),
+	).Hex(), nil
+}
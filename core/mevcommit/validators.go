@@ -0,0 +1,161 @@
+package mevcommit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// BLSPubkeyLength is the length of a validator BLS public key in bytes.
+const BLSPubkeyLength = 48
+
+// ValidatorStatus is the opt-in state of a validator in ValidatorRegistry.
+type ValidatorStatus struct {
+	Pubkey          []byte
+	Staked          bool
+	StakedAmount    *big.Int
+	UnstakingAmount *big.Int
+	// BlocksTillWithdrawAllowed is how many L1 blocks are left before an unstaked validator can withdraw.
+	BlocksTillWithdrawAllowed *big.Int
+}
+
+// ParseBLSPubkey decodes a hex encoded BLS public key.
+func ParseBLSPubkey(s string) ([]byte, error) {
+	key, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BLS pubkey %q: %v", s, err)
+	}
+	if len(key) != BLSPubkeyLength {
+		return nil, fmt.Errorf("invalid BLS pubkey %q: must be %d bytes, got %d", s, BLSPubkeyLength, len(key))
+	}
+	return key, nil
+}
+
+// StakedValidators pages through getStakedValidators, pageSize keys at a time, and returns every staked BLS key
+// and the version of the staked set. All pages are read at the same block so the set can't change in between.
+func StakedValidators(client *ethclient.Client, pageSize uint64) ([][]byte, *big.Int, error) {
+	if pageSize == 0 {
+		return nil, nil, fmt.Errorf("page size must be greater than 0")
+	}
+
+	validatorRegistry, err := ValidatorRegistryContract(client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	head, err := client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get block number: %v", err)
+	}
+	opts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(head)}
+
+	count, version, err := validatorRegistry.GetNumberOfStakedValidators(opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to call getNumberOfStakedValidators function: %v", err)
+	}
+
+	var keys [][]byte
+	for start := uint64(0); start < count.Uint64(); start += pageSize {
+		end := start + pageSize
+		if end > count.Uint64() {
+			end = count.Uint64()
+		}
+		page, _, err := validatorRegistry.GetStakedValidators(opts, new(big.Int).SetUint64(start), new(big.Int).SetUint64(end))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to call getStakedValidators function for %d-%d: %v", start, end, err)
+		}
+		keys = append(keys, page...)
+	}
+	return keys, version, nil
+}
+
+// ValidatorStatuses returns the opt-in state of each BLS key.
+func ValidatorStatuses(client *ethclient.Client, pubkeys [][]byte) ([]ValidatorStatus, error) {
+	validatorRegistry, err := ValidatorRegistryContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]ValidatorStatus, 0, len(pubkeys))
+	for _, key := range pubkeys {
+		status := ValidatorStatus{Pubkey: key}
+
+		if status.Staked, err = validatorRegistry.IsStaked(nil, key); err != nil {
+			return nil, fmt.Errorf("failed to call isStaked function for %s: %v", hexutil.Encode(key), err)
+		}
+		if status.StakedAmount, err = validatorRegistry.GetStakedAmount(nil, key); err != nil {
+			return nil, fmt.Errorf("failed to call getStakedAmount function for %s: %v", hexutil.Encode(key), err)
+		}
+		if status.UnstakingAmount, err = validatorRegistry.GetUnstakingAmount(nil, key); err != nil {
+			return nil, fmt.Errorf("failed to call getUnstakingAmount function for %s: %v", hexutil.Encode(key), err)
+		}
+		if status.BlocksTillWithdrawAllowed, err = validatorRegistry.GetBlocksTillWithdrawAllowed(nil, key); err != nil {
+			return nil, fmt.Errorf("failed to call getBlocksTillWithdrawAllowed function for %s: %v", hexutil.Encode(key), err)
+		}
+
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// StakeValidators opts the BLS keys in by staking amount wei for each of them.
+func StakeValidators(client *ethclient.Client, authAcct *AuthAcct, pubkeys [][]byte, amount *big.Int) (*types.Transaction, error) {
+	validatorRegistry, err := ValidatorRegistryContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	// the contract splits the value evenly between the keys
+	opts := *authAcct.Auth
+	opts.Value = new(big.Int).Mul(amount, big.NewInt(int64(len(pubkeys))))
+
+	tx, err := validatorRegistry.Stake(&opts, pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stake transaction: %v", err)
+	}
+	return waitValidatorTx(client, tx, "stake")
+}
+
+// UnstakeValidators starts the unstake period of the BLS keys, opting them out.
+func UnstakeValidators(client *ethclient.Client, authAcct *AuthAcct, pubkeys [][]byte) (*types.Transaction, error) {
+	validatorRegistry, err := ValidatorRegistryContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := validatorRegistry.Unstake(authAcct.Auth, pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create unstake transaction: %v", err)
+	}
+	return waitValidatorTx(client, tx, "unstake")
+}
+
+// WithdrawValidators withdraws the stake of unstaked BLS keys once their unstake period is over.
+func WithdrawValidators(client *ethclient.Client, authAcct *AuthAcct, pubkeys [][]byte) (*types.Transaction, error) {
+	validatorRegistry, err := ValidatorRegistryContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := validatorRegistry.Withdraw(authAcct.Auth, pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create withdraw transaction: %v", err)
+	}
+	return waitValidatorTx(client, tx, "withdraw")
+}
+
+func waitValidatorTx(client *ethclient.Client, tx *types.Transaction, action string) (*types.Transaction, error) {
+	receipt, err := bind.WaitMined(context.Background(), client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("%s transaction mining error: %v", action, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("%s transaction %s failed", action, tx.Hash().Hex())
+	}
+	return tx, nil
+}
@@ -0,0 +1,275 @@
+package mevcommit
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	pb "github.com/primev/preconf_blob_bidder/core/bidderpb"
+)
+
+var (
+	// ErrDigestMismatch is returned when a commitment digest does not match the hash rebuilt from its fields.
+	ErrDigestMismatch = errors.New("commitment digest mismatch")
+	// ErrBidDigestMismatch is returned when the bid digest a provider received does not match the bid that was sent.
+	ErrBidDigestMismatch = errors.New("bid digest mismatch")
+	// ErrSignerMismatch is returned when a signature does not recover to the expected address.
+	ErrSignerMismatch = errors.New("signer mismatch")
+)
+
+// PreConfDomain holds the EIP-712 domain separators and type hashes PreConfCommitmentStore uses to hash bids and commitments.
+type PreConfDomain struct {
+	BidDomainSeparator        common.Hash
+	BidTypeHash               common.Hash
+	CommitmentDomainSeparator common.Hash
+	CommitmentTypeHash        common.Hash
+}
+
+// DefaultPreConfDomain matches the constants set by PreConfCommitmentStore on deployment.
+var DefaultPreConfDomain = PreConfDomain{
+	BidDomainSeparator:        eip712DomainSeparator("PreConfBid", "1"),
+	BidTypeHash:               crypto.Keccak256Hash([]byte("PreConfBid(string txnHash,uint64 bid,uint64 blockNumber,uint64 decayStartTimeStamp,uint64 decayEndTimeStamp)")),
+	CommitmentDomainSeparator: eip712DomainSeparator("PreConfCommitment", "1"),
+	CommitmentTypeHash:        crypto.Keccak256Hash([]byte("PreConfCommitment(string txnHash,uint64 bid,uint64 blockNumber,uint64 decayStartTimeStamp,uint64 decayEndTimeStamp,bytes32 bidHash,string signature,string sharedSecretKey)")),
+}
+
+// FetchPreConfDomain reads the domain separators and type hashes from the deployed PreConfCommitmentStore contract.
+func FetchPreConfDomain(client *ethclient.Client) (*PreConfDomain, error) {
+	preConfStore, err := PreConfCommitmentStoreContract(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var domain PreConfDomain
+	for _, field := range []struct {
+		method string
+		call   func(*bind.CallOpts) ([32]byte, error)
+		dst    *common.Hash
+	}{
+		{"DOMAIN_SEPARATOR_BID", preConfStore.DOMAINSEPARATORBID, &domain.BidDomainSeparator},
+		{"EIP712_BID_TYPEHASH", preConfStore.EIP712BIDTYPEHASH, &domain.BidTypeHash},
+		{"DOMAIN_SEPARATOR_PRECONF", preConfStore.DOMAINSEPARATORPRECONF, &domain.CommitmentDomainSeparator},
+		{"EIP712_COMMITMENT_TYPEHASH", preConfStore.EIP712COMMITMENTTYPEHASH, &domain.CommitmentTypeHash},
+	} {
+		value, err := field.call(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call %s function: %v", field.method, err)
+		}
+		*field.dst = value
+	}
+
+	return &domain, nil
+}
+
+// BidHash computes the bid digest exactly like PreConfCommitmentStore.getBidHash. txnHash is the
+// comma separated list of tx hashes without 0x prefix, as sent to the bidder node.
+func (d PreConfDomain) BidHash(txnHash string, bid, blockNumber, decayStart, decayEnd uint64) common.Hash {
+	structHash := crypto.Keccak256(
+		d.BidTypeHash.Bytes(),
+		crypto.Keccak256([]byte(txnHash)),
+		uint64Word(bid),
+		uint64Word(blockNumber),
+		uint64Word(decayStart),
+		uint64Word(decayEnd),
+	)
+	return typedDataHash(d.BidDomainSeparator, structHash)
+}
+
+// PreConfHash rebuilds the commitment digest exactly like PreConfCommitmentStore.getPreConfHash. bidSignature and
+// sharedSecretKey are the raw bytes; the contract hashes them as lowercase hex strings without 0x prefix.
+func (d PreConfDomain) PreConfHash(txnHash string, bid, blockNumber, decayStart, decayEnd uint64, bidHash common.Hash, bidSignature, sharedSecretKey []byte) common.Hash {
+	structHash := crypto.Keccak256(
+		d.CommitmentTypeHash.Bytes(),
+		crypto.Keccak256([]byte(txnHash)),
+		uint64Word(bid),
+		uint64Word(blockNumber),
+		uint64Word(decayStart),
+		uint64Word(decayEnd),
+		crypto.Keccak256([]byte(hex.EncodeToString(bidHash.Bytes()))),
+		crypto.Keccak256([]byte(hex.EncodeToString(bidSignature))),
+		crypto.Keccak256([]byte(hex.EncodeToString(sharedSecretKey))),
+	)
+	return typedDataHash(d.CommitmentDomainSeparator, structHash)
+}
+
+// CommitmentVerifier checks the digests and signatures of commitments returned by providers.
+type CommitmentVerifier struct {
+	Domain PreConfDomain
+}
+
+// NewCommitmentVerifier creates a verifier for the given domain. Use DefaultPreConfDomain unless the
+// deployment differs, in which case FetchPreConfDomain reads the values from the contract.
+func NewCommitmentVerifier(domain PreConfDomain) *CommitmentVerifier {
+	return &CommitmentVerifier{Domain: domain}
+}
+
+// VerifyCommitment checks that CommitmentSignature was produced by ProviderAddress over CommitmentDigest.
+// The bidder API does not return the shared secret key, so the digest itself can only be rebuilt when the
+// caller passes sharedSecretKey, e.g. from the CommitmentStored event once the commitment is opened.
+// With a nil sharedSecretKey only the signer is checked.
+func (v *CommitmentVerifier) VerifyCommitment(c *pb.Commitment, sharedSecretKey []byte) error {
+	digest, err := decodeHash(c.CommitmentDigest)
+	if err != nil {
+		return fmt.Errorf("invalid commitment digest: %w", err)
+	}
+
+	if sharedSecretKey != nil {
+		expected, err := v.rebuildDigest(c, sharedSecretKey)
+		if err != nil {
+			return err
+		}
+		if expected != digest {
+			return fmt.Errorf("%w: got %s, expected %s", ErrDigestMismatch, digest.Hex(), expected.Hex())
+		}
+	}
+
+	if !common.IsHexAddress(c.ProviderAddress) {
+		return fmt.Errorf("invalid provider address %q", c.ProviderAddress)
+	}
+	signer, err := recoverSigner(digest, c.CommitmentSignature)
+	if err != nil {
+		return fmt.Errorf("invalid commitment signature: %w", err)
+	}
+	if provider := common.HexToAddress(c.ProviderAddress); signer != provider {
+		return fmt.Errorf("%w: commitment signed by %s, provider is %s", ErrSignerMismatch, signer.Hex(), provider.Hex())
+	}
+
+	return nil
+}
+
+// VerifyBid checks that the bid a provider committed to is the bid that was sent: ReceivedBidDigest must equal
+// the digest of sent, and ReceivedBidSignature must be signed by bidder, the mev-commit bidder node's address.
+func (v *CommitmentVerifier) VerifyBid(c *pb.Commitment, sent *pb.Bid, bidder common.Address) error {
+	bid, ok := new(big.Int).SetString(sent.Amount, 10)
+	if !ok || !bid.IsUint64() {
+		return fmt.Errorf("invalid bid amount %q", sent.Amount)
+	}
+
+	expected := v.Domain.BidHash(
+		strings.Join(sent.TxHashes, ","),
+		bid.Uint64(),
+		uint64(sent.BlockNumber),
+		uint64(sent.DecayStartTimestamp),
+		uint64(sent.DecayEndTimestamp),
+	)
+
+	digest, err := decodeHash(c.ReceivedBidDigest)
+	if err != nil {
+		return fmt.Errorf("invalid bid digest: %w", err)
+	}
+	if digest != expected {
+		return fmt.Errorf("%w: got %s, expected %s", ErrBidDigestMismatch, digest.Hex(), expected.Hex())
+	}
+
+	signer, err := recoverSigner(digest, c.ReceivedBidSignature)
+	if err != nil {
+		return fmt.Errorf("invalid bid signature: %w", err)
+	}
+	if signer != bidder {
+		return fmt.Errorf("%w: bid signed by %s, bidder is %s", ErrSignerMismatch, signer.Hex(), bidder.Hex())
+	}
+
+	return nil
+}
+
+// FilterCommitments splits commitments into the ones that pass VerifyCommitment and the ones that don't,
+// keyed by the verification error.
+func (v *CommitmentVerifier) FilterCommitments(commitments []*pb.Commitment) ([]*pb.Commitment, map[*pb.Commitment]error) {
+	var valid []*pb.Commitment
+	invalid := make(map[*pb.Commitment]error)
+	for _, c := range commitments {
+		if err := v.VerifyCommitment(c, nil); err != nil {
+			invalid[c] = err
+			continue
+		}
+		valid = append(valid, c)
+	}
+	return valid, invalid
+}
+
+func (v *CommitmentVerifier) rebuildDigest(c *pb.Commitment, sharedSecretKey []byte) (common.Hash, error) {
+	bid, ok := new(big.Int).SetString(c.BidAmount, 10)
+	if !ok || !bid.IsUint64() {
+		return common.Hash{}, fmt.Errorf("invalid bid amount %q", c.BidAmount)
+	}
+	bidHash, err := decodeHash(c.ReceivedBidDigest)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid bid digest: %w", err)
+	}
+	bidSignature, err := decodeHex(c.ReceivedBidSignature)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid bid signature: %w", err)
+	}
+
+	return v.Domain.PreConfHash(
+		strings.Join(c.TxHashes, ","),
+		bid.Uint64(),
+		uint64(c.BlockNumber),
+		uint64(c.DecayStartTimestamp),
+		uint64(c.DecayEndTimestamp),
+		bidHash,
+		bidSignature,
+		sharedSecretKey,
+	), nil
+}
+
+// recoverSigner returns the address that signed digest. Signatures with a v value of 27/28 are accepted.
+func recoverSigner(digest common.Hash, signatureHex string) (common.Address, error) {
+	sig, err := decodeHex(signatureHex)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+func eip712DomainSeparator(name, version string) common.Hash {
+	return crypto.Keccak256Hash(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version)")),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(version)),
+	)
+}
+
+// typedDataHash mirrors OpenZeppelin's ECDSA.toTypedDataHash.
+func typedDataHash(domainSeparator common.Hash, structHash []byte) common.Hash {
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash)
+}
+
+// uint64Word ABI-encodes a uint64 as a 32-byte word.
+func uint64Word(v uint64) []byte {
+	return common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+func decodeHash(s string) (common.Hash, error) {
+	b, err := decodeHex(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("hash must be %d bytes, got %d", common.HashLength, len(b))
+	}
+	return common.BytesToHash(b), nil
+}
@@ -0,0 +1,135 @@
+package mevcommit
+
+import (
+	"encoding/hex"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// preConfTestInput is the bid and commitment hashed by the tests below.
+var preConfTestInput = struct {
+	txnHash                       string
+	bid, blockNumber              uint64
+	decayStart, decayEnd          uint64
+	bidSignature, sharedSecretKey []byte
+}{
+	txnHash:         "0ac2a7f7b1d4e8f1e0c3b6a9d2f5e8b1c4a7d0e3f6b9c2a5d8e1f4b7c0a3d6e9",
+	bid:             2000000000000000,
+	blockNumber:     1234567,
+	decayStart:      1718000000000,
+	decayEnd:        1718000012000,
+	bidSignature:    common.FromHex("0x8a5f4c1b9e7d3a2c6f0b8e4d1a7c3f9b2e6d0a4c8f1b5e9d3a7c2f6b0e4d8a1c5f9b3e7d2a6c0f4b8e1d5a9c3f7b2e6d0a4c8f1b5e9d3a7c2f6b0e4d8a1c1b"),
+	sharedSecretKey: common.FromHex("0x03b1f4e8a2c6d0b9e3f7a1c5d8b2e6f0a4c7d1b5e9f3a6c0d4b8e2f5a9c3d7b1e5"),
+}
+
+// Digests of preConfTestInput under DefaultPreConfDomain. The bid digest agrees with go-ethereum's EIP-712
+// encoder; TestPreConfHashesMatchContract checks both against the holesky contract. A change to the type hashes,
+// the domains or the encoding of any field changes them.
+var (
+	wantBidHash     = common.HexToHash("0x4fddf505bf8a10766c01e015ffe03c68baa2063857e8d0caa90565b9a4b6a3ff")
+	wantPreConfHash = common.HexToHash("0x3745adcee53aec46f4b176b591b3e4cbcfa5242d6258dddd34a7300ff6ee9f2f")
+)
+
+func preConfTestHashes() (bidHash, preConfHash common.Hash) {
+	in := preConfTestInput
+	bidHash = DefaultPreConfDomain.BidHash(in.txnHash, in.bid, in.blockNumber, in.decayStart, in.decayEnd)
+	preConfHash = DefaultPreConfDomain.PreConfHash(in.txnHash, in.bid, in.blockNumber, in.decayStart, in.decayEnd, bidHash, in.bidSignature, in.sharedSecretKey)
+	return bidHash, preConfHash
+}
+
+func TestPreConfHashesKnownAnswer(t *testing.T) {
+	bidHash, preConfHash := preConfTestHashes()
+	if bidHash != wantBidHash {
+		t.Errorf("BidHash = %s, want %s", bidHash.Hex(), wantBidHash.Hex())
+	}
+	if preConfHash != wantPreConfHash {
+		t.Errorf("PreConfHash = %s, want %s", preConfHash.Hex(), wantPreConfHash.Hex())
+	}
+}
+
+// TestBidHashMatchesEIP712 checks BidHash against go-ethereum's EIP-712 encoder. The commitment can't be checked
+// the same way: the contract declares bidHash as bytes32 in the type but hashes it as a hex string.
+func TestBidHashMatchesEIP712(t *testing.T) {
+	in := preConfTestInput
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "version", Type: "string"}},
+			"PreConfBid": {
+				{Name: "txnHash", Type: "string"},
+				{Name: "bid", Type: "uint64"},
+				{Name: "blockNumber", Type: "uint64"},
+				{Name: "decayStartTimeStamp", Type: "uint64"},
+				{Name: "decayEndTimeStamp", Type: "uint64"},
+			},
+		},
+		PrimaryType: "PreConfBid",
+		Domain:      apitypes.TypedDataDomain{Name: "PreConfBid", Version: "1"},
+		Message: apitypes.TypedDataMessage{
+			"txnHash":             in.txnHash,
+			"bid":                 strconv.FormatUint(in.bid, 10),
+			"blockNumber":         strconv.FormatUint(in.blockNumber, 10),
+			"decayStartTimeStamp": strconv.FormatUint(in.decayStart, 10),
+			"decayEndTimeStamp":   strconv.FormatUint(in.decayEnd, 10),
+		},
+	}
+	want, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bidHash, _ := preConfTestHashes(); bidHash != common.BytesToHash(want) {
+		t.Errorf("BidHash = %s, EIP-712 encoder gives %x", bidHash.Hex(), want)
+	}
+}
+
+// TestPreConfHashesMatchContract compares the digests with getBidHash and getPreConfHash of the holesky
+// PreConfCommitmentStore. It needs a mev-commit chain RPC in PRECONF_TEST_MEV_COMMIT_RPC.
+func TestPreConfHashesMatchContract(t *testing.T) {
+	endpoint := os.Getenv("PRECONF_TEST_MEV_COMMIT_RPC")
+	if endpoint == "" {
+		t.Skip("PRECONF_TEST_MEV_COMMIT_RPC is not set")
+	}
+	client, err := NewGethClient(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	network, err := LookupNetwork("holesky")
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetNetwork(network)
+
+	domain, err := FetchPreConfDomain(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *domain != DefaultPreConfDomain {
+		t.Errorf("contract domain %+v differs from DefaultPreConfDomain %+v", *domain, DefaultPreConfDomain)
+	}
+
+	preConfStore, err := PreConfCommitmentStoreContract(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := preConfTestInput
+	bidHash, preConfHash := preConfTestHashes()
+	contractBidHash, err := preConfStore.GetBidHash(nil, in.txnHash, in.bid, in.blockNumber, in.decayStart, in.decayEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.Hash(contractBidHash) != bidHash {
+		t.Errorf("BidHash = %s, getBidHash gives %x", bidHash.Hex(), contractBidHash)
+	}
+	contractPreConfHash, err := preConfStore.GetPreConfHash(nil, in.txnHash, in.bid, in.blockNumber, in.decayStart, in.decayEnd,
+		bidHash, hex.EncodeToString(in.bidSignature), hex.EncodeToString(in.sharedSecretKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if common.Hash(contractPreConfHash) != preConfHash {
+		t.Errorf("PreConfHash = %s, getPreConfHash gives %x", preConfHash.Hex(), contractPreConfHash)
+	}
+}
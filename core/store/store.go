@@ -0,0 +1,48 @@
+// Package store persists the JSON state files kept under data/.
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ReadJSON decodes the JSON file at filename into v. A missing file leaves v untouched and is not an error.
+func ReadJSON(filename string, v interface{}) error {
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", filename, err)
+	}
+	return nil
+}
+
+// WriteJSON encodes v to filename. The file is written to a temporary file first and then renamed,
+// so a crash never leaves a partially written state file behind.
+func WriteJSON(filename string, v interface{}) error {
+	dir := filepath.Dir(filename)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", filename, err)
+	}
+
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, filename); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", filename, err)
+	}
+	return nil
+}